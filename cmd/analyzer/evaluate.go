@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/service"
+)
+
+func runEvaluation(ctx context.Context, analyzerService *service.AnalyzerService, userID, period string, horizon int, methods string) error {
+	var methodList []string
+	if methods != "" {
+		for _, method := range strings.Split(methods, ",") {
+			methodList = append(methodList, strings.TrimSpace(method))
+		}
+	}
+
+	results, err := analyzerService.EvaluateForecast(ctx, userID, models.TimePeriod(strings.ToUpper(period)), horizon, methodList)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "METHOD\tSAMPLES\tEXPENSE MAE\tEXPENSE MAPE %\tEXPENSE BIAS\tINCOME MAE\tINCOME MAPE %\tINCOME BIAS\t")
+	for _, r := range results {
+		fmt.Fprintf(w, "%s\t%d\t%.0f\t%.1f\t%.0f\t%.0f\t%.1f\t%.0f\t\n",
+			r.Method,
+			r.Expense.Samples,
+			r.Expense.MAE,
+			r.Expense.MAPE,
+			r.Expense.Bias,
+			r.Income.MAE,
+			r.Income.MAPE,
+			r.Income.Bias,
+		)
+	}
+
+	return w.Flush()
+}
@@ -18,6 +18,10 @@ import (
 
 func main() {
 	configPath := flag.String("config", "config.yaml", "path to config file")
+	evaluateUser := flag.String("evaluate", "", "run forecast backtest for the given user_id and exit")
+	evaluatePeriod := flag.String("period", "MONTH", "period for -evaluate: DAY, WEEK, MONTH, PAY_CYCLE, QUARTER, HALF_YEAR, YEAR or FISCAL_YEAR")
+	evaluateHorizon := flag.Int("horizon", 1, "periods ahead to score for -evaluate")
+	evaluateMethods := flag.String("methods", "", "comma-separated forecast methods for -evaluate (default: all)")
 	flag.Parse()
 
 	cfg, err := config.Load(*configPath)
@@ -43,10 +47,26 @@ func main() {
 	}
 	log.Info("database connected successfully")
 
-	transactionStorage := storage.NewPostgresStorage(db.Pool(), &cfg.Analytics.Recurring)
+	if err := db.Migrate(ctx); err != nil {
+		log.Error("failed to migrate database", "error", err)
+		db.Close()
+		os.Exit(1)
+	}
+
+	transactionStorage := storage.NewPostgresStorage(db.Pool(), &cfg.Analytics.Recurring, &cfg.Analytics.Duplicates)
 
 	analyzerService := service.NewAnalyzerService(transactionStorage, log, &cfg.Analytics)
 
+	if *evaluateUser != "" {
+		err := runEvaluation(ctx, analyzerService, *evaluateUser, *evaluatePeriod, *evaluateHorizon, *evaluateMethods)
+		db.Close()
+		if err != nil {
+			log.Error("forecast evaluation failed", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	analyzerHandler := handler.NewAnalyzerHandler(analyzerService, log)
 
 	grpcServer := server.NewGRPCServer(&cfg.Server, analyzerHandler, log)
@@ -0,0 +1,173 @@
+// Package cadence classifies recurring series into billing cycles and steps
+// their payment dates. Storage classifies with the same buckets the service
+// predicts with, so a quarterly premium is both detected and expected every
+// three months.
+package cadence
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+type cadenceSpec struct {
+	name          string
+	cadence       models.RecurringCadence
+	lengthDays    float64
+	toleranceDays float64
+}
+
+// cadences lists the supported cycles, shortest first, with their average
+// length and default tolerance in days.
+var cadences = []cadenceSpec{
+	{name: "weekly", cadence: models.RecurringCadenceWeekly, lengthDays: 7, toleranceDays: 1},
+	{name: "biweekly", cadence: models.RecurringCadenceBiweekly, lengthDays: 14, toleranceDays: 2},
+	{name: "monthly", cadence: models.RecurringCadenceMonthly, lengthDays: 30.44, toleranceDays: 5},
+	{name: "quarterly", cadence: models.RecurringCadenceQuarterly, lengthDays: 91.31, toleranceDays: 10},
+	{name: "semiannual", cadence: models.RecurringCadenceSemiannual, lengthDays: 182.62, toleranceDays: 15},
+	{name: "annual", cadence: models.RecurringCadenceAnnual, lengthDays: 365.25, toleranceDays: 20},
+}
+
+// Bucket accepts series whose average interval lies in [MinDays, MaxDays]
+// and that have at least MinOccurrences intervals.
+type Bucket struct {
+	Cadence        models.RecurringCadence
+	LengthDays     float64
+	MinDays        float64
+	MaxDays        float64
+	MinOccurrences int
+}
+
+// Buckets builds the enabled buckets from the config. Without cadences it
+// keeps the legacy monthly window [interval_min_days, interval_max_days].
+func Buckets(cfg config.RecurringConfig) ([]Bucket, error) {
+	if len(cfg.Cadences) == 0 {
+		return []Bucket{{
+			Cadence:        models.RecurringCadenceMonthly,
+			LengthDays:     30.44,
+			MinDays:        float64(cfg.IntervalMinDays),
+			MaxDays:        float64(cfg.IntervalMaxDays),
+			MinOccurrences: cfg.MinOccurrences,
+		}}, nil
+	}
+
+	for name := range cfg.Cadences {
+		if lookup(name) == nil {
+			return nil, fmt.Errorf("unknown recurring cadence: %s", name)
+		}
+	}
+
+	var buckets []Bucket
+	for _, spec := range cadences {
+		cadenceCfg, ok := cfg.Cadences[spec.name]
+		if !ok {
+			continue
+		}
+
+		tolerance := cadenceCfg.ToleranceDays
+		if tolerance <= 0 {
+			tolerance = spec.toleranceDays
+		}
+		minOccurrences := cadenceCfg.MinOccurrences
+		if minOccurrences <= 0 {
+			minOccurrences = cfg.MinOccurrences
+		}
+
+		buckets = append(buckets, Bucket{
+			Cadence:        spec.cadence,
+			LengthDays:     spec.lengthDays,
+			MinDays:        spec.lengthDays - tolerance,
+			MaxDays:        spec.lengthDays + tolerance,
+			MinOccurrences: minOccurrences,
+		})
+	}
+
+	return buckets, nil
+}
+
+// MinOccurrences is the smallest interval count any bucket accepts.
+func MinOccurrences(buckets []Bucket) int {
+	result := 0
+	for i, b := range buckets {
+		if i == 0 || b.MinOccurrences < result {
+			result = b.MinOccurrences
+		}
+	}
+	return result
+}
+
+// Classify picks the bucket whose length is closest to avgIntervalDays among
+// those that accept the series.
+func Classify(buckets []Bucket, avgIntervalDays float64, occurrences int) (models.RecurringCadence, bool) {
+	var best *Bucket
+	for i := range buckets {
+		b := &buckets[i]
+		if avgIntervalDays < b.MinDays || avgIntervalDays > b.MaxDays || occurrences < b.MinOccurrences {
+			continue
+		}
+		if best == nil || math.Abs(avgIntervalDays-b.LengthDays) < math.Abs(avgIntervalDays-best.LengthDays) {
+			best = b
+		}
+	}
+
+	if best == nil {
+		return "", false
+	}
+	return best.Cadence, true
+}
+
+// Next returns the payment after t.
+func Next(c models.RecurringCadence, t time.Time) time.Time {
+	return Step(c, t, 1)
+}
+
+// Prev returns the payment before t.
+func Prev(c models.RecurringCadence, t time.Time) time.Time {
+	return Step(c, t, -1)
+}
+
+// Step returns the n-th payment counted from anchor; a negative n goes back.
+// Weeks are counted in days, the other cadences in calendar months on the
+// anchor's day of month, clamped to the last day of shorter months: a payment
+// on Jan 31 falls on Feb 29 in a leap year and returns to Mar 31. Stepping
+// always from the same anchor keeps month-end payments from drifting.
+func Step(c models.RecurringCadence, anchor time.Time, n int) time.Time {
+	switch c {
+	case models.RecurringCadenceWeekly:
+		return anchor.AddDate(0, 0, 7*n)
+	case models.RecurringCadenceBiweekly:
+		return anchor.AddDate(0, 0, 14*n)
+	case models.RecurringCadenceQuarterly:
+		return addMonths(anchor, 3*n)
+	case models.RecurringCadenceSemiannual:
+		return addMonths(anchor, 6*n)
+	case models.RecurringCadenceAnnual:
+		return addMonths(anchor, 12*n)
+	default:
+		return addMonths(anchor, n)
+	}
+}
+
+// addMonths moves t by months calendar months, keeping its day of month
+// unless the target month is shorter.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+
+	return time.Date(first.Year(), first.Month(), min(day, lastDay), hour, minute, sec, t.Nanosecond(), t.Location())
+}
+
+func lookup(name string) *cadenceSpec {
+	for i := range cadences {
+		if cadences[i].name == name {
+			return &cadences[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package cadence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+func TestBuckets_Legacy(t *testing.T) {
+	buckets, err := Buckets(config.RecurringConfig{MinOccurrences: 3, IntervalMinDays: 25, IntervalMaxDays: 35})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(buckets) != 1 || buckets[0].Cadence != models.RecurringCadenceMonthly || buckets[0].MinDays != 25 || buckets[0].MaxDays != 35 {
+		t.Errorf("expected the monthly window 25-35, got %+v", buckets)
+	}
+}
+
+func TestBuckets_UnknownCadence(t *testing.T) {
+	_, err := Buckets(config.RecurringConfig{Cadences: map[string]config.RecurringCadenceConfig{"daily": {}}})
+	if err == nil {
+		t.Error("expected error for unknown cadence")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	buckets, err := Buckets(config.RecurringConfig{
+		MinOccurrences: 3,
+		Cadences: map[string]config.RecurringCadenceConfig{
+			"weekly":    {},
+			"biweekly":  {},
+			"monthly":   {},
+			"quarterly": {ToleranceDays: 12},
+			"annual":    {MinOccurrences: 1},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		avgIntervalDays float64
+		occurrences     int
+		expected        models.RecurringCadence
+	}{
+		{7.2, 10, models.RecurringCadenceWeekly},
+		{13, 5, models.RecurringCadenceBiweekly},
+		{31, 5, models.RecurringCadenceMonthly},
+		{80, 3, models.RecurringCadenceQuarterly},
+		{366, 1, models.RecurringCadenceAnnual},
+		{45, 5, ""},
+		{182, 3, ""},
+		{91, 2, ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := Classify(buckets, tt.avgIntervalDays, tt.occurrences)
+		if got != tt.expected || ok != (tt.expected != "") {
+			t.Errorf("for %v days over %d intervals expected %q, got %q", tt.avgIntervalDays, tt.occurrences, tt.expected, got)
+		}
+	}
+
+	if got := MinOccurrences(buckets); got != 1 {
+		t.Errorf("expected the annual minimum of 1, got %d", got)
+	}
+}
+
+func TestNext(t *testing.T) {
+	last := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		cadence  models.RecurringCadence
+		expected time.Time
+	}{
+		{models.RecurringCadenceWeekly, time.Date(2024, 1, 22, 9, 0, 0, 0, time.UTC)},
+		{models.RecurringCadenceBiweekly, time.Date(2024, 1, 29, 9, 0, 0, 0, time.UTC)},
+		{models.RecurringCadenceMonthly, time.Date(2024, 2, 15, 9, 0, 0, 0, time.UTC)},
+		{models.RecurringCadenceQuarterly, time.Date(2024, 4, 15, 9, 0, 0, 0, time.UTC)},
+		{models.RecurringCadenceSemiannual, time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)},
+		{models.RecurringCadenceAnnual, time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		if got := Next(tt.cadence, last); !got.Equal(tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.cadence, tt.expected, got)
+		}
+		if got := Prev(tt.cadence, tt.expected); !got.Equal(last) {
+			t.Errorf("%s: expected %v before %v, got %v", tt.cadence, last, tt.expected, got)
+		}
+	}
+}
+
+func TestStep_MonthEnd(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		cadence  models.RecurringCadence
+		anchor   time.Time
+		n        int
+		expected time.Time
+	}{
+		{"31st into February", models.RecurringCadenceMonthly, date(2023, 1, 31), 1, date(2023, 2, 28)},
+		{"31st into leap February", models.RecurringCadenceMonthly, date(2024, 1, 31), 1, date(2024, 2, 29)},
+		{"31st back to March", models.RecurringCadenceMonthly, date(2024, 1, 31), 2, date(2024, 3, 31)},
+		{"31st into April", models.RecurringCadenceMonthly, date(2024, 1, 31), 3, date(2024, 4, 30)},
+		{"30th into February", models.RecurringCadenceMonthly, date(2023, 1, 30), 1, date(2023, 2, 28)},
+		{"29th into February", models.RecurringCadenceMonthly, date(2023, 1, 29), 1, date(2023, 2, 28)},
+		{"29th into leap February", models.RecurringCadenceMonthly, date(2024, 1, 29), 1, date(2024, 2, 29)},
+		{"31st back from March", models.RecurringCadenceMonthly, date(2024, 3, 31), -1, date(2024, 2, 29)},
+		{"31st two back from March", models.RecurringCadenceMonthly, date(2024, 3, 31), -2, date(2024, 1, 31)},
+		{"quarter from 30 November", models.RecurringCadenceQuarterly, date(2023, 11, 30), 1, date(2024, 2, 29)},
+		{"half year from 31 August", models.RecurringCadenceSemiannual, date(2023, 8, 31), 1, date(2024, 2, 29)},
+		{"leap day to next year", models.RecurringCadenceAnnual, date(2024, 2, 29), 1, date(2025, 2, 28)},
+		{"leap day to next leap year", models.RecurringCadenceAnnual, date(2024, 2, 29), 4, date(2028, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		if got := Step(tt.cadence, tt.anchor, tt.n); !got.Equal(tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.expected, got)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -35,29 +36,225 @@ type DBConfig struct {
 }
 
 type AnalyticsConfig struct {
-	Forecast  ForecastConfig  `yaml:"forecast"`
-	Anomaly   AnomalyConfig   `yaml:"anomaly"`
-	Recurring RecurringConfig `yaml:"recurring"`
+	Forecast   ForecastConfig   `yaml:"forecast"`
+	Anomaly    AnomalyConfig    `yaml:"anomaly"`
+	Recurring  RecurringConfig  `yaml:"recurring"`
+	CashFlow   CashFlowConfig   `yaml:"cash_flow"`
+	Pace       PaceConfig       `yaml:"pace"`
+	Duplicates DuplicateConfig  `yaml:"duplicates"`
+	PayCycle   PayCycleConfig   `yaml:"pay_cycle"`
+	FiscalYear FiscalYearConfig `yaml:"fiscal_year"`
 }
 
 type ForecastConfig struct {
+	Method          string                    `yaml:"method"`
+	LookbackPeriods int                       `yaml:"lookback_periods"`
+	MaxPeriodsAhead int                       `yaml:"max_periods_ahead"`
+	Window          int                       `yaml:"window"`
+	IntervalLevels  []float64                 `yaml:"interval_levels"`
+	Exponential     ExponentialForecastConfig `yaml:"exponential"`
+	Holt            HoltForecastConfig        `yaml:"holt"`
+	Seasonal        SeasonalForecastConfig    `yaml:"seasonal"`
+	Hybrid          HybridForecastConfig      `yaml:"hybrid"`
+	Backtest        BacktestConfig            `yaml:"backtest"`
+	PartialPeriod   PartialPeriodConfig       `yaml:"partial_period"`
+}
+
+type HybridForecastConfig struct {
+	Enabled bool `yaml:"enabled"`
+}
+
+type BacktestConfig struct {
 	LookbackPeriods int `yaml:"lookback_periods"`
-	MaxPeriodsAhead int `yaml:"max_periods_ahead"`
+	MinTrainPeriods int `yaml:"min_train_periods"`
+}
+
+type ExponentialForecastConfig struct {
+	Alpha float64 `yaml:"alpha"`
+}
+
+type HoltForecastConfig struct {
+	Alpha float64 `yaml:"alpha"`
+	Beta  float64 `yaml:"beta"`
+}
+
+type SeasonalForecastConfig struct {
+	Enabled         bool    `yaml:"enabled"`
+	MinPeriods      int     `yaml:"min_periods"`
+	LookbackPeriods int     `yaml:"lookback_periods"`
+	Alpha           float64 `yaml:"alpha"`
+	Beta            float64 `yaml:"beta"`
+	Gamma           float64 `yaml:"gamma"`
 }
 
 type AnomalyConfig struct {
-	LookbackPeriods      int     `yaml:"lookback_periods"`
-	DeviationThreshold   float64 `yaml:"deviation_threshold"`
-	NewCategoryThreshold int64   `yaml:"new_category_threshold"`
+	LookbackPeriods      int                      `yaml:"lookback_periods"`
+	DeviationThreshold   float64                  `yaml:"deviation_threshold"`
+	NewCategoryThreshold int64                    `yaml:"new_category_threshold"`
+	TopTransactions      int                      `yaml:"top_transactions"`
+	PartialPeriod        PartialPeriodConfig      `yaml:"partial_period"`
+	Scoring              AnomalyScoringConfig     `yaml:"scoring"`
+	Seasonality          AnomalySeasonalityConfig `yaml:"seasonality"`
+	Feedback             AnomalyFeedbackConfig    `yaml:"feedback"`
+	Adaptive             AnomalyAdaptiveConfig    `yaml:"adaptive"`
+	Transactions         TransactionAnomalyConfig `yaml:"transactions"`
+}
+
+// AnomalyAdaptiveConfig derives a user's thresholds from their history. The
+// new category threshold becomes NewCategoryIncomePercent of the average
+// income per period; the deviation threshold becomes VolatilityMultiplier
+// times the coefficient of variation of total spending, never below
+// deviation_threshold nor above MaxDeviationThreshold, so it can only raise
+// the global threshold. Thresholds the user sets take precedence.
+type AnomalyAdaptiveConfig struct {
+	Enabled                  bool    `yaml:"enabled"`
+	NewCategoryIncomePercent float64 `yaml:"new_category_income_percent"`
+	VolatilityMultiplier     float64 `yaml:"volatility_multiplier"`
+	MaxDeviationThreshold    float64 `yaml:"max_deviation_threshold"`
+}
+
+// AnomalyFeedbackConfig raises a category's thresholds by ThresholdStep
+// (a fraction) for every earlier period the user acknowledged or suppressed,
+// up to MaxMultiplier times the configured value.
+type AnomalyFeedbackConfig struct {
+	ThresholdStep float64 `yaml:"threshold_step"`
+	MaxMultiplier float64 `yaml:"max_multiplier"`
+}
+
+// AnomalySeasonalityConfig blends the same period of the previous Years
+// years into the baseline with total Weight, once a category has a year of
+// history.
+type AnomalySeasonalityConfig struct {
+	Enabled bool    `yaml:"enabled"`
+	Years   int     `yaml:"years"`
+	Weight  float64 `yaml:"weight"`
+}
+
+// AnomalyScoringConfig scores a deviation in units of the category's spread
+// over the lookback periods: 1.4826*MAD for "mad", the standard deviation for
+// "zscore". The spread is never below min_spread_percent of the expected
+// amount, so perfectly stable categories still get a finite score.
+type AnomalyScoringConfig struct {
+	Method           string             `yaml:"method"`
+	MinSpreadPercent float64            `yaml:"min_spread_percent"`
+	Thresholds       SeverityThresholds `yaml:"thresholds"`
+}
+
+// TransactionAnomalyConfig controls GetTransactionAnomalies: expenses from
+// the last recent_days are scored against lookback_months of earlier history.
+type TransactionAnomalyConfig struct {
+	LookbackMonths   int     `yaml:"lookback_months"`
+	RecentDays       int     `yaml:"recent_days"`
+	MaxRecentDays    int     `yaml:"max_recent_days"`
+	MinHistory       int     `yaml:"min_history"`
+	AmountPercentile float64 `yaml:"amount_percentile"`
+	UnusualHourShare float64 `yaml:"unusual_hour_share"`
+	MinScore         float64 `yaml:"min_score"`
+}
+
+// SeverityThresholds are the minimum scores for each severity; a score below
+// Low is not an anomaly.
+type SeverityThresholds struct {
+	Low    float64 `yaml:"low"`
+	Medium float64 `yaml:"medium"`
+	High   float64 `yaml:"high"`
 }
 
+// PartialPeriodConfig controls how the unfinished current period is used:
+// "include" as is, "exclude" it, or "prorate" it by the elapsed fraction.
+// Prorating falls back to excluding until min_elapsed_fraction has passed.
+type PartialPeriodConfig struct {
+	Mode               string  `yaml:"mode"`
+	MinElapsedFraction float64 `yaml:"min_elapsed_fraction"`
+}
+
+// RecurringConfig detects recurring payments. Without Cadences only monthly
+// series are detected, with an average interval between IntervalMinDays and
+// IntervalMaxDays; otherwise every listed cadence is detected with its own
+// tolerance. Payments to one merchant whose amounts differ by more than
+// AmountTolerancePercent form separate series.
 type RecurringConfig struct {
-	LookbackMonths    int `yaml:"lookback_months"`
-	MinOccurrences    int `yaml:"min_occurrences"`
-	IntervalMinDays   int `yaml:"interval_min_days"`
-	IntervalMaxDays   int `yaml:"interval_max_days"`
-	DateDeviationDays int `yaml:"date_deviation_days"`
-	PredictionDays    int `yaml:"prediction_days"`
+	LookbackMonths         int                               `yaml:"lookback_months"`
+	MinOccurrences         int                               `yaml:"min_occurrences"`
+	IntervalMinDays        int                               `yaml:"interval_min_days"`
+	IntervalMaxDays        int                               `yaml:"interval_max_days"`
+	DateDeviationDays      int                               `yaml:"date_deviation_days"`
+	PredictionDays         int                               `yaml:"prediction_days"`
+	AmountTolerancePercent float64                           `yaml:"amount_tolerance_percent"`
+	Cadences               map[string]RecurringCadenceConfig `yaml:"cadences"`
+}
+
+// RecurringCadenceConfig accepts a series as the cadence when its average
+// interval is within ToleranceDays of the cadence length and it has at least
+// MinOccurrences intervals. Zero values fall back to the cadence defaults
+// and min_occurrences.
+type RecurringCadenceConfig struct {
+	ToleranceDays  float64 `yaml:"tolerance_days"`
+	MinOccurrences int     `yaml:"min_occurrences"`
+}
+
+// DuplicateConfig treats two expenses on the same account with the same MCC
+// and description as a double charge when they are at most WindowHours apart
+// and their amounts differ by at most AmountTolerancePercent.
+type DuplicateConfig struct {
+	WindowHours            int     `yaml:"window_hours"`
+	AmountTolerancePercent float64 `yaml:"amount_tolerance_percent"`
+	LookbackDays           int     `yaml:"lookback_days"`
+	MaxLookbackDays        int     `yaml:"max_lookback_days"`
+}
+
+const (
+	DefaultDuplicateWindowHours     = 24
+	DefaultDuplicateAmountTolerance = 1.0
+)
+
+// Window returns the duplicate window, falling back to
+// DefaultDuplicateWindowHours. The service and the recurring query both use
+// it, so they agree on what a double charge is.
+func (c DuplicateConfig) Window() time.Duration {
+	hours := c.WindowHours
+	if hours <= 0 {
+		hours = DefaultDuplicateWindowHours
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// Tolerance returns the amount tolerance in percent, falling back to
+// DefaultDuplicateAmountTolerance.
+func (c DuplicateConfig) Tolerance() float64 {
+	if c.AmountTolerancePercent <= 0 {
+		return DefaultDuplicateAmountTolerance
+	}
+	return c.AmountTolerancePercent
+}
+
+// PaceConfig flags categories whose projected period total exceeds the
+// expected one by more than OverspendPercent, once MinElapsedFraction of
+// the period has passed.
+type PaceConfig struct {
+	LookbackPeriods    int     `yaml:"lookback_periods"`
+	MinElapsedFraction float64 `yaml:"min_elapsed_fraction"`
+	OverspendPercent   float64 `yaml:"overspend_percent"`
+}
+
+type CashFlowConfig struct {
+	HorizonDays         int   `yaml:"horizon_days"`
+	MaxHorizonDays      int   `yaml:"max_horizon_days"`
+	LookbackMonths      int   `yaml:"lookback_months"`
+	LowBalanceThreshold int64 `yaml:"low_balance_threshold"`
+}
+
+// PayCycleConfig anchors PAY_CYCLE periods. With auto_detect the anchor is the
+// pay day of the largest monthly recurring income, falling back to anchor_day.
+type PayCycleConfig struct {
+	AnchorDay  int  `yaml:"anchor_day"`
+	AutoDetect bool `yaml:"auto_detect"`
+}
+
+// FiscalYearConfig sets the month FISCAL_YEAR periods start in (1-12,
+// January when unset).
+type FiscalYearConfig struct {
+	StartMonth int `yaml:"start_month"`
 }
 
 func Load(configPath string) (*Config, error) {
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+	"fmt"
+)
+
+// migrations create the tables owned by the analyzer. Transactions and
+// accounts belong to other services and are only read. Every statement must
+// be safe to run on each start.
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS analyzer_anomaly_acknowledgements (
+		user_id TEXT NOT NULL,
+		mcc TEXT NOT NULL,
+		flow_type TEXT NOT NULL,
+		period_start TIMESTAMPTZ,
+		action TEXT NOT NULL,
+		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS analyzer_anomaly_acknowledgements_key
+		ON analyzer_anomaly_acknowledgements (user_id, mcc, flow_type, (COALESCE(period_start, 'epoch'::TIMESTAMPTZ)))`,
+	`CREATE TABLE IF NOT EXISTS analyzer_anomaly_thresholds (
+		user_id TEXT PRIMARY KEY,
+		deviation_threshold DOUBLE PRECISION,
+		new_category_threshold BIGINT,
+		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`,
+}
+
+func (db *Database) Migrate(ctx context.Context) error {
+	for _, statement := range migrations {
+		if _, err := db.pool.Exec(ctx, statement); err != nil {
+			return fmt.Errorf("failed to apply migration: %w", err)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
+	"time"
 
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/service"
@@ -51,6 +53,7 @@ func (h *AnalyzerHandler) GetStatistics(ctx context.Context, req *pb.GetStatisti
 		req.StartDate.AsTime(),
 		req.EndDate.AsTime(),
 		groupBy,
+		req.FillGaps,
 	)
 	if err != nil {
 		h.logger.Error("failed to get statistics", "error", err, "user_id", req.UserId)
@@ -69,17 +72,27 @@ func (h *AnalyzerHandler) GetForecast(ctx context.Context, req *pb.GetForecastRe
 
 	period := parseTimePeriod(req.Period)
 
-	forecasts, err := h.service.GetForecast(ctx, req.UserId, period, int(req.PeriodsAhead))
+	result, err := h.service.GetForecast(ctx, req.UserId, period, int(req.PeriodsAhead), req.Method)
 	if err != nil {
 		h.logger.Error("failed to get forecast", "error", err, "user_id", req.UserId)
 		return nil, err
 	}
 
 	return &pb.GetForecastResponse{
-		Forecasts: convertForecastsToPB(forecasts),
+		Forecasts:    convertForecastsToPB(result.Forecasts),
+		Method:       result.Method,
+		IncomeTrend:  convertForecastTrendToPB(result.IncomeTrend),
+		ExpenseTrend: convertForecastTrendToPB(result.ExpenseTrend),
 	}, nil
 }
 
+func convertForecastTrendToPB(trend models.ForecastTrend) *pb.ForecastTrend {
+	return &pb.ForecastTrend{
+		Slope:        trend.Slope,
+		SlopePercent: trend.SlopePercent,
+	}
+}
+
 func parseTimePeriod(pbPeriod pbcommon.TimePeriod) models.TimePeriod {
 	switch pbPeriod {
 	case pbcommon.TimePeriod_TIME_PERIOD_MONTH:
@@ -88,6 +101,16 @@ func parseTimePeriod(pbPeriod pbcommon.TimePeriod) models.TimePeriod {
 		return models.TimePeriodQuarter
 	case pbcommon.TimePeriod_TIME_PERIOD_YEAR:
 		return models.TimePeriodYear
+	case pbcommon.TimePeriod_TIME_PERIOD_WEEK:
+		return models.TimePeriodWeek
+	case pbcommon.TimePeriod_TIME_PERIOD_DAY:
+		return models.TimePeriodDay
+	case pbcommon.TimePeriod_TIME_PERIOD_PAY_CYCLE:
+		return models.TimePeriodPayCycle
+	case pbcommon.TimePeriod_TIME_PERIOD_HALF_YEAR:
+		return models.TimePeriodHalfYear
+	case pbcommon.TimePeriod_TIME_PERIOD_FISCAL_YEAR:
+		return models.TimePeriodFiscalYear
 	default:
 		return models.TimePeriodMonth
 	}
@@ -123,17 +146,38 @@ func convertCategoriesToPB(categories []models.CategoryStats) []*pb.CategorySpen
 	return result
 }
 
-func convertForecastsToPB(forecasts []models.PeriodStats) []*pb.Forecast {
+func convertForecastsToPB(forecasts []models.Forecast) []*pb.Forecast {
 	result := make([]*pb.Forecast, 0, len(forecasts))
 
 	for _, f := range forecasts {
 		result = append(result, &pb.Forecast{
-			PeriodStart:       timestamppb.New(f.PeriodStart),
-			PeriodEnd:         timestamppb.New(f.PeriodEnd),
-			ExpectedIncome:    &pbcommon.Money{Amount: f.Income, Currency: "RUB"},
-			ExpectedExpense:   &pbcommon.Money{Amount: f.Expense, Currency: "RUB"},
-			ExpectedBalance:   &pbcommon.Money{Amount: f.Balance, Currency: "RUB"},
-			CategoryBreakdown: convertCategoriesToPB(f.Categories),
+			PeriodStart:          timestamppb.New(f.PeriodStart),
+			PeriodEnd:            timestamppb.New(f.PeriodEnd),
+			ExpectedIncome:       &pbcommon.Money{Amount: f.Income, Currency: "RUB"},
+			ExpectedExpense:      &pbcommon.Money{Amount: f.Expense, Currency: "RUB"},
+			ExpectedBalance:      &pbcommon.Money{Amount: f.Balance, Currency: "RUB"},
+			CategoryBreakdown:    convertCategoriesToPB(f.Categories),
+			Intervals:            convertForecastIntervalsToPB(f.Intervals),
+			CommittedExpense:     &pbcommon.Money{Amount: f.CommittedExpense, Currency: "RUB"},
+			DiscretionaryExpense: &pbcommon.Money{Amount: f.DiscretionaryExpense, Currency: "RUB"},
+		})
+	}
+
+	return result
+}
+
+func convertForecastIntervalsToPB(intervals []models.ForecastInterval) []*pb.ForecastInterval {
+	result := make([]*pb.ForecastInterval, 0, len(intervals))
+
+	for _, i := range intervals {
+		result = append(result, &pb.ForecastInterval{
+			Level:        i.Level,
+			IncomeLower:  &pbcommon.Money{Amount: i.IncomeLower, Currency: "RUB"},
+			IncomeUpper:  &pbcommon.Money{Amount: i.IncomeUpper, Currency: "RUB"},
+			ExpenseLower: &pbcommon.Money{Amount: i.ExpenseLower, Currency: "RUB"},
+			ExpenseUpper: &pbcommon.Money{Amount: i.ExpenseUpper, Currency: "RUB"},
+			BalanceLower: &pbcommon.Money{Amount: i.BalanceLower, Currency: "RUB"},
+			BalanceUpper: &pbcommon.Money{Amount: i.BalanceUpper, Currency: "RUB"},
 		})
 	}
 
@@ -145,17 +189,40 @@ func (h *AnalyzerHandler) GetAnomalies(ctx context.Context, req *pb.GetAnomalies
 
 	period := parseTimePeriod(req.Period)
 
-	anomalies, err := h.service.GetAnomalies(ctx, req.UserId, period)
+	anomalies, thresholds, err := h.service.GetAnomalies(ctx, req.UserId, period, parseOptionalTime(req.PeriodStart))
 	if err != nil {
 		h.logger.Error("failed to get anomalies", "error", err, "user_id", req.UserId)
 		return nil, err
 	}
 
 	return &pb.GetAnomaliesResponse{
-		Anomalies: convertAnomaliesToPB(anomalies),
+		Anomalies:  convertAnomaliesToPB(anomalies),
+		Thresholds: convertAnomalyThresholdsToPB(thresholds),
 	}, nil
 }
 
+func convertAnomalyThresholdsToPB(thresholds *models.AnomalyThresholds) *pb.AnomalyThresholds {
+	return &pb.AnomalyThresholds{
+		DeviationThreshold:   thresholds.DeviationThreshold,
+		DeviationSource:      convertThresholdSourceToPB(thresholds.DeviationSource),
+		NewCategoryThreshold: &pbcommon.Money{Amount: thresholds.NewCategoryThreshold, Currency: "RUB"},
+		NewCategorySource:    convertThresholdSourceToPB(thresholds.NewCategorySource),
+	}
+}
+
+func convertThresholdSourceToPB(source models.ThresholdSource) pb.ThresholdSource {
+	switch source {
+	case models.ThresholdSourceConfig:
+		return pb.ThresholdSource_THRESHOLD_SOURCE_CONFIG
+	case models.ThresholdSourceAdaptive:
+		return pb.ThresholdSource_THRESHOLD_SOURCE_ADAPTIVE
+	case models.ThresholdSourceUser:
+		return pb.ThresholdSource_THRESHOLD_SOURCE_USER
+	default:
+		return pb.ThresholdSource_THRESHOLD_SOURCE_UNSPECIFIED
+	}
+}
+
 func convertAnomaliesToPB(anomalies []models.CategoryAnomaly) []*pb.CategoryAnomaly {
 	result := make([]*pb.CategoryAnomaly, 0, len(anomalies))
 
@@ -165,6 +232,281 @@ func convertAnomaliesToPB(anomalies []models.CategoryAnomaly) []*pb.CategoryAnom
 			ActualAmount:    &pbcommon.Money{Amount: a.ActualAmount, Currency: "RUB"},
 			ExpectedAmount:  &pbcommon.Money{Amount: a.ExpectedAmount, Currency: "RUB"},
 			DeviationAmount: &pbcommon.Money{Amount: a.DeviationAmount, Currency: "RUB"},
+			Score:           a.Score,
+			Severity:        convertAnomalySeverityToPB(a.Severity),
+			Direction:       convertAnomalyDirectionToPB(a.Direction),
+			FlowType:        convertTransactionTypeToPB(a.FlowType),
+			Baseline:        convertBaselineToPB(a.Baseline),
+			TopTransactions: convertAnomalyTransactionsToPB(a.TopTransactions),
+			PeriodStart:     timestamppb.New(a.PeriodStart),
+			Acknowledged:    a.Acknowledged,
+		})
+	}
+
+	return result
+}
+
+func convertBaselineToPB(baseline []models.BaselinePeriod) []*pb.BaselinePeriod {
+	result := make([]*pb.BaselinePeriod, 0, len(baseline))
+
+	for _, b := range baseline {
+		result = append(result, &pb.BaselinePeriod{
+			PeriodStart: timestamppb.New(b.PeriodStart),
+			Amount:      &pbcommon.Money{Amount: b.Amount, Currency: "RUB"},
+			Weight:      b.Weight,
+		})
+	}
+
+	return result
+}
+
+func convertAnomalyTransactionsToPB(transactions []models.Transaction) []*pb.AnomalyTransaction {
+	result := make([]*pb.AnomalyTransaction, 0, len(transactions))
+
+	for _, tx := range transactions {
+		result = append(result, &pb.AnomalyTransaction{
+			TransactionId: tx.ID,
+			AccountId:     tx.AccountID,
+			Amount:        &pbcommon.Money{Amount: tx.Amount, Currency: tx.Currency},
+			Description:   tx.Description,
+			CreatedAt:     timestamppb.New(tx.CreatedAt),
+		})
+	}
+
+	return result
+}
+
+func convertAnomalySeverityToPB(severity models.AnomalySeverity) pb.AnomalySeverity {
+	switch severity {
+	case models.AnomalySeverityLow:
+		return pb.AnomalySeverity_ANOMALY_SEVERITY_LOW
+	case models.AnomalySeverityMedium:
+		return pb.AnomalySeverity_ANOMALY_SEVERITY_MEDIUM
+	case models.AnomalySeverityHigh:
+		return pb.AnomalySeverity_ANOMALY_SEVERITY_HIGH
+	default:
+		return pb.AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED
+	}
+}
+
+func convertAnomalyDirectionToPB(direction models.AnomalyDirection) pb.AnomalyDirection {
+	switch direction {
+	case models.AnomalyDirectionAbove:
+		return pb.AnomalyDirection_ANOMALY_DIRECTION_ABOVE
+	case models.AnomalyDirectionBelow:
+		return pb.AnomalyDirection_ANOMALY_DIRECTION_BELOW
+	default:
+		return pb.AnomalyDirection_ANOMALY_DIRECTION_UNSPECIFIED
+	}
+}
+
+func convertTransactionTypeToPB(txType models.TransactionType) pbcommon.TransactionType {
+	switch txType {
+	case models.TransactionTypeIncome:
+		return pbcommon.TransactionType_TRANSACTION_TYPE_INCOME
+	case models.TransactionTypeExpense:
+		return pbcommon.TransactionType_TRANSACTION_TYPE_EXPENSE
+	case models.TransactionTypeTransfer:
+		return pbcommon.TransactionType_TRANSACTION_TYPE_TRANSFER
+	default:
+		return pbcommon.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	}
+}
+
+func (h *AnalyzerHandler) AcknowledgeAnomaly(ctx context.Context, req *pb.AcknowledgeAnomalyRequest) (*pb.AcknowledgeAnomalyResponse, error) {
+	h.logger.Info("AcknowledgeAnomaly called", "user_id", req.UserId, "mcc", req.Mcc)
+
+	err := h.service.AcknowledgeAnomaly(ctx, req.UserId, req.Mcc, parseTransactionType(req.FlowType), parseTimePeriod(req.Period), parseOptionalTime(req.PeriodStart))
+	if err != nil {
+		h.logger.Error("failed to acknowledge anomaly", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.AcknowledgeAnomalyResponse{}, nil
+}
+
+func (h *AnalyzerHandler) SuppressAnomaly(ctx context.Context, req *pb.SuppressAnomalyRequest) (*pb.SuppressAnomalyResponse, error) {
+	h.logger.Info("SuppressAnomaly called", "user_id", req.UserId, "mcc", req.Mcc)
+
+	err := h.service.SuppressAnomaly(ctx, req.UserId, req.Mcc, parseTransactionType(req.FlowType), parseTimePeriod(req.Period), parseOptionalTime(req.PeriodStart))
+	if err != nil {
+		h.logger.Error("failed to suppress anomaly", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.SuppressAnomalyResponse{}, nil
+}
+
+func (h *AnalyzerHandler) UnsuppressAnomaly(ctx context.Context, req *pb.UnsuppressAnomalyRequest) (*pb.UnsuppressAnomalyResponse, error) {
+	h.logger.Info("UnsuppressAnomaly called", "user_id", req.UserId, "mcc", req.Mcc)
+
+	err := h.service.UnsuppressAnomaly(ctx, req.UserId, req.Mcc, parseTransactionType(req.FlowType), parseTimePeriod(req.Period), parseOptionalTime(req.PeriodStart))
+	if err != nil {
+		h.logger.Error("failed to unsuppress anomaly", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.UnsuppressAnomalyResponse{}, nil
+}
+
+func (h *AnalyzerHandler) SetAnomalyThresholds(ctx context.Context, req *pb.SetAnomalyThresholdsRequest) (*pb.SetAnomalyThresholdsResponse, error) {
+	h.logger.Info("SetAnomalyThresholds called", "user_id", req.UserId)
+
+	var newCategoryThreshold *int64
+	if req.NewCategoryThreshold != nil {
+		newCategoryThreshold = &req.NewCategoryThreshold.Amount
+	}
+
+	err := h.service.SetAnomalyThresholds(ctx, req.UserId, req.DeviationThreshold, newCategoryThreshold)
+	if err != nil {
+		h.logger.Error("failed to set anomaly thresholds", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.SetAnomalyThresholdsResponse{}, nil
+}
+
+func parseTransactionType(pbType pbcommon.TransactionType) models.TransactionType {
+	switch pbType {
+	case pbcommon.TransactionType_TRANSACTION_TYPE_INCOME:
+		return models.TransactionTypeIncome
+	case pbcommon.TransactionType_TRANSACTION_TYPE_EXPENSE:
+		return models.TransactionTypeExpense
+	case pbcommon.TransactionType_TRANSACTION_TYPE_TRANSFER:
+		return models.TransactionTypeTransfer
+	default:
+		return ""
+	}
+}
+
+// parseOptionalTime maps an unset timestamp to the zero time.
+func parseOptionalTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func (h *AnalyzerHandler) GetTransactionAnomalies(ctx context.Context, req *pb.GetTransactionAnomaliesRequest) (*pb.GetTransactionAnomaliesResponse, error) {
+	h.logger.Info("GetTransactionAnomalies called", "user_id", req.UserId, "days", req.Days)
+
+	anomalies, err := h.service.GetTransactionAnomalies(ctx, req.UserId, int(req.Days))
+	if err != nil {
+		h.logger.Error("failed to get transaction anomalies", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.GetTransactionAnomaliesResponse{
+		Anomalies: convertTransactionAnomaliesToPB(anomalies),
+	}, nil
+}
+
+func convertTransactionAnomaliesToPB(anomalies []models.TransactionAnomaly) []*pb.TransactionAnomaly {
+	result := make([]*pb.TransactionAnomaly, 0, len(anomalies))
+
+	for _, a := range anomalies {
+		reasons := make([]pb.TransactionAnomalyReason, 0, len(a.Reasons))
+		for _, reason := range a.Reasons {
+			reasons = append(reasons, convertTransactionAnomalyReasonToPB(reason))
+		}
+
+		result = append(result, &pb.TransactionAnomaly{
+			TransactionId:    a.Transaction.ID,
+			AccountId:        a.Transaction.AccountID,
+			Amount:           &pbcommon.Money{Amount: a.Transaction.Amount, Currency: a.Transaction.Currency},
+			Mcc:              convertMCCToPB(a.Transaction.MCC),
+			Description:      a.Transaction.Description,
+			CreatedAt:        timestamppb.New(a.Transaction.CreatedAt),
+			Score:            a.Score,
+			AmountPercentile: a.AmountPercentile,
+			Reasons:          reasons,
+		})
+	}
+
+	return result
+}
+
+// convertMCCToPB formats a transaction MCC the way category stats name it.
+func convertMCCToPB(mcc *int32) string {
+	if mcc == nil {
+		return "uncategorized"
+	}
+	return strconv.Itoa(int(*mcc))
+}
+
+func convertTransactionAnomalyReasonToPB(reason models.TransactionAnomalyReason) pb.TransactionAnomalyReason {
+	switch reason {
+	case models.TransactionAnomalyReasonAmount:
+		return pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE
+	case models.TransactionAnomalyReasonUnusualHour:
+		return pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR
+	case models.TransactionAnomalyReasonFirstTimeMerchant:
+		return pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT
+	default:
+		return pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_UNSPECIFIED
+	}
+}
+
+func (h *AnalyzerHandler) GetSpendingPace(ctx context.Context, req *pb.GetSpendingPaceRequest) (*pb.GetSpendingPaceResponse, error) {
+	h.logger.Info("GetSpendingPace called", "user_id", req.UserId)
+
+	period := parseTimePeriod(req.Period)
+
+	pace, err := h.service.GetSpendingPace(ctx, req.UserId, period)
+	if err != nil {
+		h.logger.Error("failed to get spending pace", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.GetSpendingPaceResponse{
+		PeriodStart:     timestamppb.New(pace.PeriodStart),
+		PeriodEnd:       timestamppb.New(pace.PeriodEnd),
+		ElapsedFraction: pace.ElapsedFraction,
+		Categories:      convertCategoryPaceToPB(pace.Categories),
+	}, nil
+}
+
+func convertCategoryPaceToPB(categories []models.CategoryPace) []*pb.CategoryPace {
+	result := make([]*pb.CategoryPace, 0, len(categories))
+
+	for _, c := range categories {
+		result = append(result, &pb.CategoryPace{
+			Mcc:             c.MCC,
+			SpentAmount:     &pbcommon.Money{Amount: c.SpentAmount, Currency: "RUB"},
+			ExpectedAmount:  &pbcommon.Money{Amount: c.ExpectedAmount, Currency: "RUB"},
+			ProjectedAmount: &pbcommon.Money{Amount: c.ProjectedAmount, Currency: "RUB"},
+			TypicalShare:    c.TypicalShare,
+			PaceRatio:       c.PaceRatio,
+			Exceeding:       c.Exceeding,
+		})
+	}
+
+	return result
+}
+
+func (h *AnalyzerHandler) GetDuplicateCharges(ctx context.Context, req *pb.GetDuplicateChargesRequest) (*pb.GetDuplicateChargesResponse, error) {
+	h.logger.Info("GetDuplicateCharges called", "user_id", req.UserId, "days", req.Days)
+
+	duplicates, err := h.service.GetDuplicateCharges(ctx, req.UserId, int(req.Days))
+	if err != nil {
+		h.logger.Error("failed to get duplicate charges", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	return &pb.GetDuplicateChargesResponse{
+		Duplicates: convertDuplicateChargesToPB(duplicates),
+	}, nil
+}
+
+func convertDuplicateChargesToPB(duplicates []models.DuplicateCharge) []*pb.DuplicateCharge {
+	result := make([]*pb.DuplicateCharge, 0, len(duplicates))
+
+	for _, d := range duplicates {
+		charges := convertAnomalyTransactionsToPB([]models.Transaction{d.Original, d.Duplicate})
+		result = append(result, &pb.DuplicateCharge{
+			Mcc:       convertMCCToPB(d.Duplicate.MCC),
+			Original:  charges[0],
+			Duplicate: charges[1],
 		})
 	}
 
@@ -193,6 +535,122 @@ func convertRecurringPaymentsToPB(payments []models.RecurringPayment) []*pb.Recu
 			Mcc:           p.MCC,
 			TypicalAmount: &pbcommon.Money{Amount: p.TypicalAmount, Currency: "RUB"},
 			ExpectedDate:  timestamppb.New(p.ExpectedDate),
+			Cadence:       convertRecurringCadenceToPB(p.Cadence),
+			Merchant:      p.Merchant,
+		})
+	}
+
+	return result
+}
+
+func convertRecurringCadenceToPB(c models.RecurringCadence) pb.RecurringCadence {
+	switch c {
+	case models.RecurringCadenceWeekly:
+		return pb.RecurringCadence_RECURRING_CADENCE_WEEKLY
+	case models.RecurringCadenceBiweekly:
+		return pb.RecurringCadence_RECURRING_CADENCE_BIWEEKLY
+	case models.RecurringCadenceMonthly:
+		return pb.RecurringCadence_RECURRING_CADENCE_MONTHLY
+	case models.RecurringCadenceQuarterly:
+		return pb.RecurringCadence_RECURRING_CADENCE_QUARTERLY
+	case models.RecurringCadenceSemiannual:
+		return pb.RecurringCadence_RECURRING_CADENCE_SEMIANNUAL
+	case models.RecurringCadenceAnnual:
+		return pb.RecurringCadence_RECURRING_CADENCE_ANNUAL
+	default:
+		return pb.RecurringCadence_RECURRING_CADENCE_UNSPECIFIED
+	}
+}
+
+func (h *AnalyzerHandler) EvaluateForecast(ctx context.Context, req *pb.EvaluateForecastRequest) (*pb.EvaluateForecastResponse, error) {
+	h.logger.Info("EvaluateForecast called", "user_id", req.UserId)
+
+	period := parseTimePeriod(req.Period)
+
+	results, err := h.service.EvaluateForecast(ctx, req.UserId, period, int(req.Horizon), req.Methods)
+	if err != nil {
+		h.logger.Error("failed to evaluate forecast", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	bestMethod := ""
+	if len(results) > 0 && results[0].Expense.Samples > 0 {
+		bestMethod = results[0].Method
+	}
+
+	return &pb.EvaluateForecastResponse{
+		Results:    convertForecastAccuracyToPB(results),
+		BestMethod: bestMethod,
+	}, nil
+}
+
+func convertForecastAccuracyToPB(results []models.ForecastAccuracy) []*pb.ForecastAccuracy {
+	result := make([]*pb.ForecastAccuracy, 0, len(results))
+
+	for _, r := range results {
+		result = append(result, &pb.ForecastAccuracy{
+			Method:  r.Method,
+			Income:  convertAccuracyMetricsToPB(r.Income),
+			Expense: convertAccuracyMetricsToPB(r.Expense),
+		})
+	}
+
+	return result
+}
+
+func convertAccuracyMetricsToPB(m models.AccuracyMetrics) *pb.AccuracyMetrics {
+	return &pb.AccuracyMetrics{
+		Mae:     m.MAE,
+		Mape:    m.MAPE,
+		Bias:    m.Bias,
+		Samples: int32(m.Samples),
+	}
+}
+
+func (h *AnalyzerHandler) GetCashFlowProjection(ctx context.Context, req *pb.GetCashFlowProjectionRequest) (*pb.GetCashFlowProjectionResponse, error) {
+	h.logger.Info("GetCashFlowProjection called", "user_id", req.UserId)
+
+	var threshold *int64
+	if req.Threshold != nil {
+		threshold = &req.Threshold.Amount
+	}
+
+	var currentBalance *int64
+	if req.CurrentBalance != nil {
+		currentBalance = &req.CurrentBalance.Amount
+	}
+
+	projection, err := h.service.GetCashFlowProjection(ctx, req.UserId, int(req.HorizonDays), threshold, currentBalance)
+	if err != nil {
+		h.logger.Error("failed to get cash flow projection", "error", err, "user_id", req.UserId)
+		return nil, err
+	}
+
+	resp := &pb.GetCashFlowProjectionResponse{
+		StartingBalance:    &pbcommon.Money{Amount: projection.StartingBalance, Currency: "RUB"},
+		DailyDiscretionary: &pbcommon.Money{Amount: projection.DailyDiscretionary, Currency: "RUB"},
+		Days:               convertDailyBalancesToPB(projection.Days),
+	}
+
+	if projection.BelowZeroDate != nil {
+		resp.BelowZeroDate = timestamppb.New(*projection.BelowZeroDate)
+	}
+	if projection.BelowThresholdDate != nil {
+		resp.BelowThresholdDate = timestamppb.New(*projection.BelowThresholdDate)
+	}
+
+	return resp, nil
+}
+
+func convertDailyBalancesToPB(days []models.DailyBalance) []*pb.DailyBalance {
+	result := make([]*pb.DailyBalance, 0, len(days))
+
+	for _, d := range days {
+		result = append(result, &pb.DailyBalance{
+			Date:    timestamppb.New(d.Date),
+			Income:  &pbcommon.Money{Amount: d.Income, Currency: "RUB"},
+			Expense: &pbcommon.Money{Amount: d.Expense, Currency: "RUB"},
+			Balance: &pbcommon.Money{Amount: d.Balance, Currency: "RUB"},
 		})
 	}
 
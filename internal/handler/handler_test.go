@@ -9,6 +9,7 @@ import (
 
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/service"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
 	pb "github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/pkg/api/analyzer"
@@ -16,11 +17,30 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// monthsAgo returns the start of the month n months before the current one,
+// so forecast history ends at the period the service treats as current.
+func monthsAgo(n int) time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month()-time.Month(n), 1, 0, 0, 0, 0, now.Location())
+}
+
+// periodsAgo returns the start of the period of the given unit n periods
+// before the current one.
+func periodsAgo(unit models.TimePeriod, n int) time.Time {
+	p := period.New(unit)
+	return p.Add(p.Truncate(time.Now()), -n)
+}
+
 func getDefaultTestConfig() *config.AnalyticsConfig {
 	return &config.AnalyticsConfig{
 		Forecast: config.ForecastConfig{
+			Method:          "auto",
 			LookbackPeriods: 6,
 			MaxPeriodsAhead: 12,
+			Window:          6,
+			Exponential: config.ExponentialForecastConfig{
+				Alpha: 0.3,
+			},
 		},
 		Anomaly: config.AnomalyConfig{
 			LookbackPeriods:      6,
@@ -177,6 +197,48 @@ func TestGetStatistics_Handler_MultiplePeriods(t *testing.T) {
 	}
 }
 
+func TestGetStatistics_Handler_FillGapsFlag(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetStatisticsFunc = func(ctx context.Context, req storage.GetStatisticsRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Income: 100000},
+			{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Income: 120000},
+		}, nil
+	}
+
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, cfg)
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	req := &pb.GetStatisticsRequest{
+		UserId:    "user-123",
+		StartDate: timestamppb.New(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:   timestamppb.New(time.Date(2024, 3, 31, 23, 59, 59, 0, time.UTC)),
+		GroupBy:   pbcommon.TimePeriod_TIME_PERIOD_MONTH,
+	}
+
+	resp, err := handler.GetStatistics(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(resp.PeriodData) != 2 {
+		t.Errorf("expected 2 sparse periods by default, got %d", len(resp.PeriodData))
+	}
+
+	req.FillGaps = true
+	resp, err = handler.GetStatistics(context.Background(), req)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(resp.PeriodData) != 3 {
+		t.Errorf("expected 3 dense periods, got %d", len(resp.PeriodData))
+	}
+}
+
 func TestGetStatistics_Handler_CurrencyIsRUB(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := getDefaultTestConfig()
@@ -233,11 +295,11 @@ func TestGetForecast_Handler_Success(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
 		return []models.PeriodStats{
-			{Income: 100000, Expense: 50000, Balance: 50000},
-			{Income: 95000, Expense: 48000, Balance: 47000},
-			{Income: 90000, Expense: 45000, Balance: 45000},
+			{PeriodStart: monthsAgo(1), Income: 100000, Expense: 50000, Balance: 50000},
+			{PeriodStart: monthsAgo(2), Income: 95000, Expense: 48000, Balance: 47000},
+			{PeriodStart: monthsAgo(3), Income: 90000, Expense: 45000, Balance: 45000},
 		}, nil
 	}
 
@@ -277,6 +339,47 @@ func TestGetForecast_Handler_Success(t *testing.T) {
 	if forecast.ExpectedIncome.Currency != "RUB" {
 		t.Errorf("expected currency RUB, got %s", forecast.ExpectedIncome.Currency)
 	}
+
+	if resp.Method != "wma" {
+		t.Errorf("expected method wma, got %s", resp.Method)
+	}
+}
+
+func TestGetForecast_Handler_MethodOverride(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: monthsAgo(0), Income: 100000, Expense: 50000},
+			{PeriodStart: monthsAgo(1), Income: 90000, Expense: 40000},
+		}, nil
+	}
+
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, cfg)
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	req := &pb.GetForecastRequest{
+		UserId:       "user-123",
+		Period:       pbcommon.TimePeriod_TIME_PERIOD_MONTH,
+		PeriodsAhead: 1,
+		Method:       "sma",
+	}
+
+	resp, err := handler.GetForecast(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if resp.Method != "sma" {
+		t.Errorf("expected method sma, got %s", resp.Method)
+	}
+
+	if resp.Forecasts[0].ExpectedIncome.Amount != 95000 {
+		t.Errorf("expected SMA income 95000, got %d", resp.Forecasts[0].ExpectedIncome.Amount)
+	}
 }
 
 func TestGetForecast_Handler_QuarterlyPeriod(t *testing.T) {
@@ -284,13 +387,13 @@ func TestGetForecast_Handler_QuarterlyPeriod(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
-		if groupBy != models.TimePeriodQuarter {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period.Unit != models.TimePeriodQuarter {
 			t.Error("expected TimePeriodQuarter")
 		}
 		return []models.PeriodStats{
-			{Income: 300000, Expense: 150000},
-			{Income: 280000, Expense: 140000},
+			{PeriodStart: periodsAgo(models.TimePeriodQuarter, 1), Income: 300000, Expense: 150000},
+			{PeriodStart: periodsAgo(models.TimePeriodQuarter, 2), Income: 280000, Expense: 140000},
 		}, nil
 	}
 
@@ -319,13 +422,13 @@ func TestGetForecast_Handler_YearlyPeriod(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
-		if groupBy != models.TimePeriodYear {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period.Unit != models.TimePeriodYear {
 			t.Error("expected TimePeriodYear")
 		}
 		return []models.PeriodStats{
-			{Income: 1200000, Expense: 600000},
-			{Income: 1150000, Expense: 580000},
+			{PeriodStart: periodsAgo(models.TimePeriodYear, 1), Income: 1200000, Expense: 600000},
+			{PeriodStart: periodsAgo(models.TimePeriodYear, 2), Income: 1150000, Expense: 580000},
 		}, nil
 	}
 
@@ -358,6 +461,11 @@ func TestParseTimePeriod_AllValues(t *testing.T) {
 		{"Month", pbcommon.TimePeriod_TIME_PERIOD_MONTH, models.TimePeriodMonth},
 		{"Quarter", pbcommon.TimePeriod_TIME_PERIOD_QUARTER, models.TimePeriodQuarter},
 		{"Year", pbcommon.TimePeriod_TIME_PERIOD_YEAR, models.TimePeriodYear},
+		{"Week", pbcommon.TimePeriod_TIME_PERIOD_WEEK, models.TimePeriodWeek},
+		{"Day", pbcommon.TimePeriod_TIME_PERIOD_DAY, models.TimePeriodDay},
+		{"PayCycle", pbcommon.TimePeriod_TIME_PERIOD_PAY_CYCLE, models.TimePeriodPayCycle},
+		{"HalfYear", pbcommon.TimePeriod_TIME_PERIOD_HALF_YEAR, models.TimePeriodHalfYear},
+		{"FiscalYear", pbcommon.TimePeriod_TIME_PERIOD_FISCAL_YEAR, models.TimePeriodFiscalYear},
 		{"Unspecified", pbcommon.TimePeriod_TIME_PERIOD_UNSPECIFIED, models.TimePeriodMonth},
 	}
 
@@ -371,6 +479,22 @@ func TestParseTimePeriod_AllValues(t *testing.T) {
 	}
 }
 
+func TestConvertRecurringPaymentsToPB(t *testing.T) {
+	payments := []models.RecurringPayment{
+		{MCC: "6300", Merchant: "Insurance Co", Cadence: models.RecurringCadenceQuarterly, TypicalAmount: 120000, ExpectedDate: time.Date(2024, 6, 25, 0, 0, 0, 0, time.UTC)},
+		{MCC: "5411", TypicalAmount: 80000, ExpectedDate: time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)},
+	}
+
+	result := convertRecurringPaymentsToPB(payments)
+
+	if result[0].Cadence != pb.RecurringCadence_RECURRING_CADENCE_QUARTERLY || result[0].Merchant != "Insurance Co" {
+		t.Errorf("expected QUARTERLY payment to Insurance Co, got %v", result[0])
+	}
+	if result[1].Cadence != pb.RecurringCadence_RECURRING_CADENCE_UNSPECIFIED {
+		t.Errorf("expected UNSPECIFIED without cadence, got %v", result[1].Cadence)
+	}
+}
+
 func TestConvertPeriodsToPB_EmptyCategories(t *testing.T) {
 	periods := []models.PeriodStats{
 		{
@@ -419,3 +543,351 @@ func TestConvertCategoriesToPB_MultipleCategories(t *testing.T) {
 		t.Errorf("expected category uncategorized, got %s", result[2].CategoryId)
 	}
 }
+
+func TestConvertForecastsToPB_Intervals(t *testing.T) {
+	forecasts := []models.Forecast{
+		{
+			PeriodStats: models.PeriodStats{
+				PeriodStart: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+				PeriodEnd:   time.Date(2024, 7, 31, 23, 59, 59, 0, time.UTC),
+				Income:      100000,
+				Expense:     60000,
+				Balance:     40000,
+			},
+			Intervals: []models.ForecastInterval{
+				{
+					Level:        80,
+					IncomeLower:  90000,
+					IncomeUpper:  110000,
+					ExpenseLower: 50000,
+					ExpenseUpper: 70000,
+					BalanceLower: 20000,
+					BalanceUpper: 60000,
+				},
+			},
+		},
+	}
+
+	result := convertForecastsToPB(forecasts)
+
+	if len(result) != 1 {
+		t.Fatalf("expected 1 forecast, got %d", len(result))
+	}
+
+	if len(result[0].Intervals) != 1 {
+		t.Fatalf("expected 1 interval, got %d", len(result[0].Intervals))
+	}
+
+	interval := result[0].Intervals[0]
+	if interval.Level != 80 {
+		t.Errorf("expected level 80, got %v", interval.Level)
+	}
+
+	if interval.ExpenseLower.Amount != 50000 || interval.ExpenseUpper.Amount != 70000 {
+		t.Errorf("expected expense bounds [50000, 70000], got [%d, %d]", interval.ExpenseLower.Amount, interval.ExpenseUpper.Amount)
+	}
+
+	if interval.BalanceUpper.Currency != "RUB" {
+		t.Errorf("expected currency RUB, got %s", interval.BalanceUpper.Currency)
+	}
+}
+
+func TestConvertForecastsToPB_CommittedExpense(t *testing.T) {
+	forecasts := []models.Forecast{
+		{
+			PeriodStats: models.PeriodStats{
+				Income:  100000,
+				Expense: 60000,
+				Balance: 40000,
+			},
+			CommittedExpense:     35000,
+			DiscretionaryExpense: 25000,
+		},
+	}
+
+	result := convertForecastsToPB(forecasts)
+
+	if result[0].CommittedExpense.Amount != 35000 {
+		t.Errorf("expected committed expense 35000, got %d", result[0].CommittedExpense.Amount)
+	}
+
+	if result[0].DiscretionaryExpense.Amount != 25000 {
+		t.Errorf("expected discretionary expense 25000, got %d", result[0].DiscretionaryExpense.Amount)
+	}
+}
+
+func TestConvertForecastTrendToPB(t *testing.T) {
+	trend := convertForecastTrendToPB(models.ForecastTrend{Slope: 3000, SlopePercent: 2.94})
+
+	if trend.Slope != 3000 || trend.SlopePercent != 2.94 {
+		t.Errorf("expected slope 3000 (2.94%%), got %v (%v%%)", trend.Slope, trend.SlopePercent)
+	}
+}
+
+func TestConvertAnomaliesToPB_Severity(t *testing.T) {
+	anomalies := []models.CategoryAnomaly{
+		{MCC: "4900", ActualAmount: 6000, ExpectedAmount: 5000, DeviationAmount: 1000, Score: 2.5, Severity: models.AnomalySeverityLow},
+		{MCC: "5411", ActualAmount: 90000, ExpectedAmount: 30000, DeviationAmount: 60000, Score: 12, Severity: models.AnomalySeverityHigh},
+	}
+
+	result := convertAnomaliesToPB(anomalies)
+
+	if result[0].Severity != pb.AnomalySeverity_ANOMALY_SEVERITY_LOW || result[0].Score != 2.5 {
+		t.Errorf("expected low severity with score 2.5, got %v %v", result[0].Severity, result[0].Score)
+	}
+	if result[1].Severity != pb.AnomalySeverity_ANOMALY_SEVERITY_HIGH {
+		t.Errorf("expected high severity, got %v", result[1].Severity)
+	}
+}
+
+func TestConvertAnomaliesToPB_Direction(t *testing.T) {
+	anomalies := []models.CategoryAnomaly{
+		{MCC: "uncategorized", FlowType: models.TransactionTypeIncome, Direction: models.AnomalyDirectionBelow, ActualAmount: 0, ExpectedAmount: 120000, DeviationAmount: -120000},
+	}
+
+	result := convertAnomaliesToPB(anomalies)
+
+	if result[0].Direction != pb.AnomalyDirection_ANOMALY_DIRECTION_BELOW {
+		t.Errorf("expected direction below, got %v", result[0].Direction)
+	}
+	if result[0].FlowType != pbcommon.TransactionType_TRANSACTION_TYPE_INCOME {
+		t.Errorf("expected income flow, got %v", result[0].FlowType)
+	}
+	if result[0].DeviationAmount.Amount != -120000 {
+		t.Errorf("expected negative deviation, got %d", result[0].DeviationAmount.Amount)
+	}
+}
+
+func TestConvertAnomaliesToPB_Explanation(t *testing.T) {
+	anomalies := []models.CategoryAnomaly{
+		{
+			MCC:             "5812",
+			Baseline:        []models.BaselinePeriod{{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Amount: 30000, Weight: 0.6}},
+			TopTransactions: []models.Transaction{{ID: "tx-1", Amount: 60000, Currency: "RUB", Description: "Restaurant"}},
+		},
+	}
+
+	result := convertAnomaliesToPB(anomalies)
+
+	if len(result[0].Baseline) != 1 || result[0].Baseline[0].Weight != 0.6 || result[0].Baseline[0].Amount.Amount != 30000 {
+		t.Errorf("expected baseline period with weight 0.6, got %v", result[0].Baseline)
+	}
+	if len(result[0].TopTransactions) != 1 || result[0].TopTransactions[0].TransactionId != "tx-1" {
+		t.Errorf("expected top transaction tx-1, got %v", result[0].TopTransactions)
+	}
+}
+
+func TestSuppressAnomaly_Handler_Permanent(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var saved models.AnomalyAcknowledgement
+	mockStorage := storage.NewMockStorage()
+	mockStorage.SaveAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		saved = ack
+		return nil
+	}
+
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	_, err := handler.SuppressAnomaly(context.Background(), &pb.SuppressAnomalyRequest{
+		UserId:   "user-123",
+		Mcc:      "uncategorized",
+		FlowType: pbcommon.TransactionType_TRANSACTION_TYPE_INCOME,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !saved.PeriodStart.IsZero() || saved.FlowType != models.TransactionTypeIncome || saved.Action != models.AnomalyActionSuppressed {
+		t.Errorf("expected permanent income suppression, got %+v", saved)
+	}
+}
+
+func TestUnsuppressAnomaly_Handler(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var deleted models.AnomalyAcknowledgement
+	mockStorage := storage.NewMockStorage()
+	mockStorage.DeleteAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		deleted = ack
+		return nil
+	}
+
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	_, err := handler.UnsuppressAnomaly(context.Background(), &pb.UnsuppressAnomalyRequest{
+		UserId:   "user-123",
+		Mcc:      "5812",
+		FlowType: pbcommon.TransactionType_TRANSACTION_TYPE_EXPENSE,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if deleted.MCC != "5812" || !deleted.PeriodStart.IsZero() || deleted.Action != models.AnomalyActionSuppressed {
+		t.Errorf("expected the permanent suppression lifted, got %+v", deleted)
+	}
+}
+
+func TestConvertCategoryPaceToPB(t *testing.T) {
+	categories := []models.CategoryPace{
+		{MCC: "5411", SpentAmount: 25000, ExpectedAmount: 30000, ProjectedAmount: 45000, TypicalShare: 0.25, PaceRatio: 2.5, Exceeding: true},
+	}
+
+	result := convertCategoryPaceToPB(categories)
+
+	if result[0].ProjectedAmount.Amount != 45000 || result[0].PaceRatio != 2.5 || !result[0].Exceeding {
+		t.Errorf("expected exceeding groceries projected at 45000, got %v", result[0])
+	}
+}
+
+func TestConvertAnomalyThresholdsToPB(t *testing.T) {
+	result := convertAnomalyThresholdsToPB(&models.AnomalyThresholds{
+		DeviationThreshold:   80,
+		DeviationSource:      models.ThresholdSourceUser,
+		NewCategoryThreshold: 10000,
+		NewCategorySource:    models.ThresholdSourceAdaptive,
+	})
+
+	if result.DeviationSource != pb.ThresholdSource_THRESHOLD_SOURCE_USER || result.NewCategorySource != pb.ThresholdSource_THRESHOLD_SOURCE_ADAPTIVE {
+		t.Errorf("expected USER and ADAPTIVE sources, got %v", result)
+	}
+	if result.NewCategoryThreshold.Amount != 10000 {
+		t.Errorf("expected new category threshold 10000, got %d", result.NewCategoryThreshold.Amount)
+	}
+}
+
+func TestConvertDuplicateChargesToPB(t *testing.T) {
+	mcc := int32(5814)
+	duplicates := []models.DuplicateCharge{
+		{
+			Original:  models.Transaction{ID: "tx-1", Amount: 45000, Currency: "RUB", MCC: &mcc},
+			Duplicate: models.Transaction{ID: "tx-2", Amount: 45000, Currency: "RUB", MCC: &mcc},
+		},
+	}
+
+	result := convertDuplicateChargesToPB(duplicates)
+
+	if result[0].Mcc != "5814" || result[0].Original.TransactionId != "tx-1" || result[0].Duplicate.TransactionId != "tx-2" {
+		t.Errorf("expected tx-2 duplicating tx-1 in 5814, got %v", result[0])
+	}
+}
+
+func TestConvertTransactionAnomaliesToPB(t *testing.T) {
+	mcc := int32(5812)
+	anomalies := []models.TransactionAnomaly{
+		{
+			Transaction: models.Transaction{ID: "tx-1", Amount: 250000, Currency: "RUB", MCC: &mcc, Description: "Sushi Bar"},
+			Score:       2,
+			Reasons: []models.TransactionAnomalyReason{
+				models.TransactionAnomalyReasonAmount,
+				models.TransactionAnomalyReasonUnusualHour,
+				models.TransactionAnomalyReasonFirstTimeMerchant,
+			},
+		},
+		{Transaction: models.Transaction{ID: "tx-2", Amount: 1000, Currency: "RUB"}, Score: 1},
+	}
+
+	result := convertTransactionAnomaliesToPB(anomalies)
+
+	if result[0].Mcc != "5812" || result[0].Amount.Amount != 250000 {
+		t.Errorf("expected mcc 5812 and amount 250000, got %s %d", result[0].Mcc, result[0].Amount.Amount)
+	}
+	expected := []pb.TransactionAnomalyReason{
+		pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE,
+		pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR,
+		pb.TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT,
+	}
+	for i, reason := range expected {
+		if result[0].Reasons[i] != reason {
+			t.Errorf("reason %d: expected %v, got %v", i, reason, result[0].Reasons[i])
+		}
+	}
+	if result[1].Mcc != "uncategorized" {
+		t.Errorf("expected uncategorized mcc, got %s", result[1].Mcc)
+	}
+}
+
+func TestEvaluateForecast_Handler_BestMethod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.Backtest = config.BacktestConfig{LookbackPeriods: 12, MinTrainPeriods: 2}
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: monthsAgo(1), Income: 100000, Expense: 80000},
+			{PeriodStart: monthsAgo(2), Income: 100000, Expense: 70000},
+			{PeriodStart: monthsAgo(3), Income: 100000, Expense: 60000},
+			{PeriodStart: monthsAgo(4), Income: 100000, Expense: 50000},
+			{PeriodStart: monthsAgo(5), Income: 100000, Expense: 40000},
+		}, nil
+	}
+
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, cfg)
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	req := &pb.EvaluateForecastRequest{
+		UserId:  "user-123",
+		Period:  pbcommon.TimePeriod_TIME_PERIOD_MONTH,
+		Horizon: 1,
+		Methods: []string{"wma", "linear"},
+	}
+
+	resp, err := handler.EvaluateForecast(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(resp.Results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(resp.Results))
+	}
+
+	if resp.BestMethod != "linear" {
+		t.Errorf("expected best method linear, got %s", resp.BestMethod)
+	}
+
+	if resp.Results[0].Expense.Samples != 3 {
+		t.Errorf("expected 3 samples, got %d", resp.Results[0].Expense.Samples)
+	}
+}
+
+func TestGetCashFlowProjection_Handler_BalanceOverride(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	analyzerService := service.NewAnalyzerService(mockStorage, logger, cfg)
+	handler := NewAnalyzerHandler(analyzerService, logger)
+
+	req := &pb.GetCashFlowProjectionRequest{
+		UserId:         "user-123",
+		HorizonDays:    5,
+		CurrentBalance: &pbcommon.Money{Amount: -100, Currency: "RUB"},
+	}
+
+	resp, err := handler.GetCashFlowProjection(context.Background(), req)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(resp.Days) != 5 {
+		t.Fatalf("expected 5 days, got %d", len(resp.Days))
+	}
+
+	if resp.StartingBalance.Amount != -100 {
+		t.Errorf("expected starting balance -100, got %d", resp.StartingBalance.Amount)
+	}
+
+	if resp.BelowZeroDate == nil || !resp.BelowZeroDate.AsTime().Equal(resp.Days[0].Date.AsTime()) {
+		t.Error("expected below zero date on the first projected day")
+	}
+
+	if resp.BelowThresholdDate != nil {
+		t.Error("expected no threshold date without a threshold")
+	}
+}
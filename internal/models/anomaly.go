@@ -8,9 +8,113 @@ type CategoryPeriodStats struct {
 	Amount      int64
 }
 
+type AnomalySeverity string
+
+const (
+	AnomalySeverityLow    AnomalySeverity = "LOW"
+	AnomalySeverityMedium AnomalySeverity = "MEDIUM"
+	AnomalySeverityHigh   AnomalySeverity = "HIGH"
+)
+
+// AnomalyDirection tells whether the actual amount came in above or below
+// the expected one.
+type AnomalyDirection string
+
+const (
+	AnomalyDirectionAbove AnomalyDirection = "ABOVE"
+	AnomalyDirectionBelow AnomalyDirection = "BELOW"
+)
+
+// AnomalyAction is the user's reaction to a category anomaly: acknowledged
+// anomalies are still reported and marked, suppressed ones are hidden.
+type AnomalyAction string
+
+const (
+	AnomalyActionAcknowledged AnomalyAction = "ACKNOWLEDGED"
+	AnomalyActionSuppressed   AnomalyAction = "SUPPRESSED"
+)
+
+// AnomalyAcknowledgement records an AnomalyAction for one category and flow.
+// A zero PeriodStart applies to every period.
+type AnomalyAcknowledgement struct {
+	UserID      string
+	MCC         string
+	FlowType    TransactionType
+	PeriodStart time.Time
+	Action      AnomalyAction
+	CreatedAt   time.Time
+}
+
+// ThresholdSource tells where an anomaly threshold came from.
+type ThresholdSource string
+
+const (
+	ThresholdSourceConfig   ThresholdSource = "CONFIG"
+	ThresholdSourceAdaptive ThresholdSource = "ADAPTIVE"
+	ThresholdSourceUser     ThresholdSource = "USER"
+)
+
+// AnomalyThresholdOverride holds the thresholds a user set for themselves.
+// A nil field falls back to the adaptive or configured value.
+type AnomalyThresholdOverride struct {
+	UserID               string
+	DeviationThreshold   *float64
+	NewCategoryThreshold *int64
+	UpdatedAt            time.Time
+}
+
+// AnomalyThresholds are the thresholds GetAnomalies applied to a user,
+// before the per-category raise from acknowledgements.
+type AnomalyThresholds struct {
+	DeviationThreshold   float64
+	DeviationSource      ThresholdSource
+	NewCategoryThreshold int64
+	NewCategorySource    ThresholdSource
+}
+
+type TransactionAnomalyReason string
+
+const (
+	TransactionAnomalyReasonAmount            TransactionAnomalyReason = "AMOUNT_PERCENTILE"
+	TransactionAnomalyReasonUnusualHour       TransactionAnomalyReason = "UNUSUAL_HOUR"
+	TransactionAnomalyReasonFirstTimeMerchant TransactionAnomalyReason = "FIRST_TIME_MERCHANT"
+)
+
+// TransactionAnomaly is a single transaction that stands out against the
+// user's history for the same MCC.
+type TransactionAnomaly struct {
+	Transaction      Transaction
+	Score            float64
+	AmountPercentile float64
+	Reasons          []TransactionAnomalyReason
+}
+
+// CategoryAnomaly is a category whose amount in the analyzed period, which
+// starts at PeriodStart, is far from expected. FlowType is INCOME or
+// EXPENSE; DeviationAmount and Score are negative for anomalies below
+// expected. Acknowledged is set once the user has acknowledged it.
+//
+// Baseline and TopTransactions explain the numbers: the periods and WMA
+// weights behind ExpectedAmount, and the largest transactions of the
+// category in the analyzed period.
 type CategoryAnomaly struct {
 	MCC             string
+	FlowType        TransactionType
+	Direction       AnomalyDirection
 	ActualAmount    int64
 	ExpectedAmount  int64
 	DeviationAmount int64
+	Score           float64
+	Severity        AnomalySeverity
+	PeriodStart     time.Time
+	Acknowledged    bool
+	Baseline        []BaselinePeriod
+	TopTransactions []Transaction
+}
+
+// BaselinePeriod is one historical period of a category's WMA baseline.
+type BaselinePeriod struct {
+	PeriodStart time.Time
+	Amount      int64
+	Weight      float64
 }
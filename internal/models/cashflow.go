@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+type CashFlowProjection struct {
+	StartingBalance    int64
+	DailyDiscretionary int64
+	Days               []DailyBalance
+	BelowZeroDate      *time.Time
+	BelowThresholdDate *time.Time
+}
+
+type DailyBalance struct {
+	Date    time.Time
+	Income  int64
+	Expense int64
+	Balance int64
+}
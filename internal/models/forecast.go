@@ -0,0 +1,45 @@
+package models
+
+type ForecastResult struct {
+	Method       string
+	Forecasts    []Forecast
+	IncomeTrend  ForecastTrend
+	ExpenseTrend ForecastTrend
+}
+
+// ForecastTrend is the slope of the historical series per period, in minor
+// units and as a percentage of the latest trend level.
+type ForecastTrend struct {
+	Slope        float64
+	SlopePercent float64
+}
+
+type Forecast struct {
+	PeriodStats
+	CommittedExpense     int64
+	DiscretionaryExpense int64
+	Intervals            []ForecastInterval
+}
+
+type ForecastInterval struct {
+	Level        float64
+	IncomeLower  int64
+	IncomeUpper  int64
+	ExpenseLower int64
+	ExpenseUpper int64
+	BalanceLower int64
+	BalanceUpper int64
+}
+
+type ForecastAccuracy struct {
+	Method  string
+	Income  AccuracyMetrics
+	Expense AccuracyMetrics
+}
+
+type AccuracyMetrics struct {
+	MAE     float64
+	MAPE    float64
+	Bias    float64
+	Samples int
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// SpendingPace compares spending so far in the current period with the
+// user's typical spending curve.
+type SpendingPace struct {
+	PeriodStart     time.Time
+	PeriodEnd       time.Time
+	ElapsedFraction float64
+	Categories      []CategoryPace
+}
+
+// CategoryPace projects a category's period-end total. TypicalShare is the
+// part of the period total usually spent by now; PaceRatio is SpentAmount
+// over that typical spend, or 0 when nothing is usually spent by now.
+type CategoryPace struct {
+	MCC             string
+	SpentAmount     int64
+	ExpectedAmount  int64
+	ProjectedAmount int64
+	TypicalShare    float64
+	PaceRatio       float64
+	Exceeding       bool
+}
@@ -2,15 +2,37 @@ package models
 
 import "time"
 
+// RecurringCadence is the billing cycle a recurring series was classified
+// into.
+type RecurringCadence string
+
+const (
+	RecurringCadenceWeekly     RecurringCadence = "WEEKLY"
+	RecurringCadenceBiweekly   RecurringCadence = "BIWEEKLY"
+	RecurringCadenceMonthly    RecurringCadence = "MONTHLY"
+	RecurringCadenceQuarterly  RecurringCadence = "QUARTERLY"
+	RecurringCadenceSemiannual RecurringCadence = "SEMIANNUAL"
+	RecurringCadenceAnnual     RecurringCadence = "ANNUAL"
+)
+
+// RecurringPattern is a detected series of payments to one merchant.
+// Merchant is the latest description of the series. Occurrences counts the
+// intervals between its payments. A pattern without a Cadence steps by its
+// average interval.
 type RecurringPattern struct {
 	MCC             string
+	Merchant        string
+	Cadence         RecurringCadence
 	MedianAmount    int64
 	AvgIntervalDays float64
+	Occurrences     int
 	LastOccurrence  time.Time
 }
 
 type RecurringPayment struct {
 	MCC           string
+	Merchant      string
+	Cadence       RecurringCadence
 	TypicalAmount int64
 	ExpectedDate  time.Time
 }
@@ -19,7 +19,12 @@ type CategoryStats struct {
 type TimePeriod string
 
 const (
-	TimePeriodMonth   TimePeriod = "MONTH"
-	TimePeriodQuarter TimePeriod = "QUARTER"
-	TimePeriodYear    TimePeriod = "YEAR"
+	TimePeriodMonth      TimePeriod = "MONTH"
+	TimePeriodQuarter    TimePeriod = "QUARTER"
+	TimePeriodYear       TimePeriod = "YEAR"
+	TimePeriodWeek       TimePeriod = "WEEK"
+	TimePeriodDay        TimePeriod = "DAY"
+	TimePeriodPayCycle   TimePeriod = "PAY_CYCLE"
+	TimePeriodHalfYear   TimePeriod = "HALF_YEAR"
+	TimePeriodFiscalYear TimePeriod = "FISCAL_YEAR"
 )
@@ -21,3 +21,11 @@ type Transaction struct {
 	Description string
 	CreatedAt   time.Time
 }
+
+// DuplicateCharge pairs an expense with an earlier near-identical one on
+// the same account. Original is the first charge of the series, so a triple
+// charge yields two pairs with the same Original.
+type DuplicateCharge struct {
+	Original  Transaction
+	Duplicate Transaction
+}
@@ -0,0 +1,166 @@
+// Package period is the single source of truth for how transactions are
+// bucketed in time. The same Period drives truncation in Go and the
+// bucketing expression in SQL, so storage rows and service math agree.
+package period
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+// MaxAnchorDay keeps every pay cycle start inside every month, so a salary
+// paid on the 30th opens the cycle on the 28th.
+const MaxAnchorDay = 28
+
+// Period is a period type together with the anchors some types need.
+// AnchorDay applies to PAY_CYCLE, FiscalStartMonth to FISCAL_YEAR; both are
+// ignored by the other types. An empty Unit behaves like MONTH.
+type Period struct {
+	Unit             models.TimePeriod
+	AnchorDay        int
+	FiscalStartMonth time.Month
+}
+
+func New(unit models.TimePeriod) Period {
+	return Period{Unit: unit}
+}
+
+func PayCycle(anchorDay int) Period {
+	return Period{Unit: models.TimePeriodPayCycle, AnchorDay: ClampAnchorDay(anchorDay)}
+}
+
+func FiscalYear(startMonth time.Month) Period {
+	return Period{Unit: models.TimePeriodFiscalYear, FiscalStartMonth: startMonth}
+}
+
+// ClampAnchorDay limits a pay day to 1..MaxAnchorDay.
+func ClampAnchorDay(day int) int {
+	return min(max(day, 1), MaxAnchorDay)
+}
+
+// Truncate returns the start of the period containing t.
+func (p Period) Truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	switch p.Unit {
+	case models.TimePeriodWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	case models.TimePeriodDay:
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	}
+
+	length, firstMonth, anchorDay := p.months()
+	if day < anchorDay {
+		month--
+	}
+	offset := ((int(month)-int(firstMonth))%length + length) % length
+	return time.Date(year, month-time.Month(offset), anchorDay, 0, 0, 0, 0, t.Location())
+}
+
+// Add moves a period start by n periods; n may be negative.
+func (p Period) Add(start time.Time, n int) time.Time {
+	switch p.Unit {
+	case models.TimePeriodWeek:
+		return start.AddDate(0, 0, 7*n)
+	case models.TimePeriodDay:
+		return start.AddDate(0, 0, n)
+	}
+
+	length, _, _ := p.months()
+	return start.AddDate(0, length*n, 0)
+}
+
+// Next returns the start of the period following the one starting at start.
+func (p Period) Next(start time.Time) time.Time {
+	return p.Add(start, 1)
+}
+
+// End returns the last instant of the period starting at start.
+func (p Period) End(start time.Time) time.Time {
+	return p.Next(start).Add(-time.Nanosecond)
+}
+
+// Contains reports whether t falls into the period starting at start.
+func (p Period) Contains(start, t time.Time) bool {
+	return !t.Before(start) && t.Before(p.Next(start))
+}
+
+// Enumerate returns the starts of every period overlapping [from, to] in
+// ascending order.
+func (p Period) Enumerate(from, to time.Time) []time.Time {
+	if to.Before(from) {
+		return nil
+	}
+
+	var starts []time.Time
+	for start := p.Truncate(from); !start.After(to); start = p.Next(start) {
+		starts = append(starts, start)
+	}
+	return starts
+}
+
+// SQLBucket returns a PostgreSQL expression that maps column to the start of
+// its period, matching Truncate. Only numbers from p are interpolated.
+func (p Period) SQLBucket(column string) string {
+	switch p.Unit {
+	case models.TimePeriodQuarter:
+		return fmt.Sprintf("DATE_TRUNC('quarter', %s)", column)
+	case models.TimePeriodYear:
+		return fmt.Sprintf("DATE_TRUNC('year', %s)", column)
+	case models.TimePeriodWeek:
+		return fmt.Sprintf("DATE_TRUNC('week', %s)", column)
+	case models.TimePeriodDay:
+		return fmt.Sprintf("DATE_TRUNC('day', %s)", column)
+	case models.TimePeriodHalfYear:
+		return fmt.Sprintf("(DATE_TRUNC('year', %[1]s) + INTERVAL '6 months' * FLOOR((EXTRACT(MONTH FROM %[1]s) - 1) / 6))", column)
+	case models.TimePeriodFiscalYear:
+		_, firstMonth, _ := p.months()
+		shift := int(firstMonth) - 1
+		return fmt.Sprintf("(DATE_TRUNC('year', %s - INTERVAL '%d months') + INTERVAL '%d months')", column, shift, shift)
+	case models.TimePeriodPayCycle:
+		_, _, anchorDay := p.months()
+		shift := anchorDay - 1
+		return fmt.Sprintf("(DATE_TRUNC('month', %s - INTERVAL '%d days') + INTERVAL '%d days')", column, shift, shift)
+	default:
+		return fmt.Sprintf("DATE_TRUNC('month', %s)", column)
+	}
+}
+
+// months describes the month-based types: length in months, the month a
+// period can start in and the day of month it starts on.
+func (p Period) months() (int, time.Month, int) {
+	switch p.Unit {
+	case models.TimePeriodQuarter:
+		return 3, time.January, 1
+	case models.TimePeriodHalfYear:
+		return 6, time.January, 1
+	case models.TimePeriodYear:
+		return 12, time.January, 1
+	case models.TimePeriodFiscalYear:
+		if p.FiscalStartMonth < time.January || p.FiscalStartMonth > time.December {
+			return 12, time.January, 1
+		}
+		return 12, p.FiscalStartMonth, 1
+	case models.TimePeriodPayCycle:
+		return 1, time.January, ClampAnchorDay(p.AnchorDay)
+	default:
+		return 1, time.January, 1
+	}
+}
+
+func (p Period) String() string {
+	switch p.Unit {
+	case models.TimePeriodPayCycle:
+		_, _, anchorDay := p.months()
+		return fmt.Sprintf("%s(day %d)", p.Unit, anchorDay)
+	case models.TimePeriodFiscalYear:
+		_, firstMonth, _ := p.months()
+		return fmt.Sprintf("%s(from %s)", p.Unit, firstMonth)
+	case "":
+		return string(models.TimePeriodMonth)
+	default:
+		return string(p.Unit)
+	}
+}
@@ -0,0 +1,403 @@
+package period
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+func TestTruncate_Month(t *testing.T) {
+	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
+	expected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodMonth).Truncate(input)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTruncate_Quarter(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result := New(models.TimePeriodQuarter).Truncate(tt.input)
+		if !result.Equal(tt.expected) {
+			t.Errorf("for input %v expected %v, got %v", tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestTruncate_Year(t *testing.T) {
+	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodYear).Truncate(input)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTruncate_Week(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, 6, 12, 14, 30, 0, 0, time.UTC), time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 6, 16, 23, 59, 0, 0, time.UTC), time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result := New(models.TimePeriodWeek).Truncate(tt.input)
+		if !result.Equal(tt.expected) {
+			t.Errorf("%v: expected %v, got %v", tt.input, tt.expected, result)
+		}
+		if result.Weekday() != time.Monday {
+			t.Errorf("%v: expected Monday, got %v", tt.input, result.Weekday())
+		}
+	}
+}
+
+func TestTruncate_Day(t *testing.T) {
+	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
+	expected := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodDay).Truncate(input)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_LookbackMonth(t *testing.T) {
+	periodStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodMonth).Add(periodStart, -3)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_LookbackQuarter(t *testing.T) {
+	periodStart := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodQuarter).Add(periodStart, -2)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_LookbackYear(t *testing.T) {
+	periodStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodYear).Add(periodStart, -2)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_Month(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodMonth).Add(base, 3)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_Quarter(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodQuarter).Add(base, 2)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_Year(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expected := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodYear).Add(base, 2)
+
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestEnd_Month(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodMonth).End(start)
+
+	expected := time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC)
+	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestEnd_Quarter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodQuarter).End(start)
+
+	expected := time.Date(2024, 3, 31, 23, 59, 59, 999999999, time.UTC)
+	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestEnd_Year(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodYear).End(start)
+
+	expected := time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestAdd_Week(t *testing.T) {
+	base := time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodWeek).Add(base, 2)
+
+	expected := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestEnd_Week(t *testing.T) {
+	start := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodWeek).End(start)
+
+	expected := time.Date(2024, 6, 16, 23, 59, 59, 999999999, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestEnd_Day(t *testing.T) {
+	start := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodDay).End(start)
+
+	expected := time.Date(2024, 6, 10, 23, 59, 59, 999999999, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTruncate_PayCycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     time.Time
+		anchorDay int
+		expected  time.Time
+	}{
+		{"after pay day", time.Date(2024, 3, 15, 10, 0, 0, 0, time.UTC), 10, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"on pay day", time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC), 10, time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{"before pay day", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), 10, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		{"across year", time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), 25, time.Date(2023, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"clamped anchor", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), 31, time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{"zero anchor is calendar month", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), 0, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PayCycle(tt.anchorDay).Truncate(tt.input)
+			if !result.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEnd_PayCycle(t *testing.T) {
+	start := time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC)
+
+	end := PayCycle(25).End(start)
+
+	expected := time.Date(2024, 2, 25, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	if !end.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, end)
+	}
+}
+
+func TestTruncate_HalfYear(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 6, 30, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result := New(models.TimePeriodHalfYear).Truncate(tt.input)
+		if !result.Equal(tt.expected) {
+			t.Errorf("for input %v expected %v, got %v", tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestEnd_HalfYear(t *testing.T) {
+	start := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	result := New(models.TimePeriodHalfYear).End(start)
+
+	expected := time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTruncate_FiscalYear(t *testing.T) {
+	tests := []struct {
+		input    time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC), time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		result := FiscalYear(time.April).Truncate(tt.input)
+		if !result.Equal(tt.expected) {
+			t.Errorf("for input %v expected %v, got %v", tt.input, tt.expected, result)
+		}
+	}
+}
+
+func TestTruncate_FiscalYearDefaultsToJanuary(t *testing.T) {
+	input := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	result := FiscalYear(0).Truncate(input)
+
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestTruncate_EmptyUnitIsMonth(t *testing.T) {
+	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
+	result := Period{}.Truncate(input)
+
+	expected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	if !result.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestContains(t *testing.T) {
+	p := PayCycle(10)
+	start := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    time.Time
+		expected bool
+	}{
+		{start, true},
+		{time.Date(2024, 4, 9, 23, 59, 59, 0, time.UTC), true},
+		{time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 3, 9, 23, 59, 59, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Contains(start, tt.input); got != tt.expected {
+			t.Errorf("%v: expected %v, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	from := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	starts := New(models.TimePeriodQuarter).Enumerate(from, to)
+
+	expected := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if len(starts) != len(expected) {
+		t.Fatalf("expected %d quarters, got %v", len(expected), starts)
+	}
+	for i := range expected {
+		if !starts[i].Equal(expected[i]) {
+			t.Errorf("quarter %d: expected %v, got %v", i, expected[i], starts[i])
+		}
+	}
+
+	if got := New(models.TimePeriodMonth).Enumerate(to, from); got != nil {
+		t.Errorf("expected nil for an inverted range, got %v", got)
+	}
+}
+
+func TestAddAndTruncateAgree(t *testing.T) {
+	periods := []Period{
+		New(models.TimePeriodDay),
+		New(models.TimePeriodWeek),
+		New(models.TimePeriodMonth),
+		New(models.TimePeriodQuarter),
+		New(models.TimePeriodHalfYear),
+		New(models.TimePeriodYear),
+		FiscalYear(time.October),
+		PayCycle(25),
+	}
+
+	at := time.Date(2024, 11, 20, 13, 0, 0, 0, time.UTC)
+	for _, p := range periods {
+		start := p.Truncate(at)
+		if !p.Contains(start, at) {
+			t.Errorf("%s: period starting %v should contain %v", p, start, at)
+		}
+		if next := p.Next(start); !p.Truncate(next).Equal(next) {
+			t.Errorf("%s: next period %v is not a period start", p, next)
+		}
+		if prev := p.Add(start, -1); !p.Truncate(p.End(prev)).Equal(prev) {
+			t.Errorf("%s: previous period end does not truncate back to %v", p, prev)
+		}
+	}
+}
+
+func TestSQLBucket(t *testing.T) {
+	tests := []struct {
+		period   Period
+		expected string
+	}{
+		{New(models.TimePeriodMonth), "DATE_TRUNC('month', created_at)"},
+		{Period{}, "DATE_TRUNC('month', created_at)"},
+		{New(models.TimePeriodQuarter), "DATE_TRUNC('quarter', created_at)"},
+		{New(models.TimePeriodYear), "DATE_TRUNC('year', created_at)"},
+		{New(models.TimePeriodWeek), "DATE_TRUNC('week', created_at)"},
+		{New(models.TimePeriodDay), "DATE_TRUNC('day', created_at)"},
+		{New(models.TimePeriodHalfYear), "(DATE_TRUNC('year', created_at) + INTERVAL '6 months' * FLOOR((EXTRACT(MONTH FROM created_at) - 1) / 6))"},
+		{FiscalYear(time.April), "(DATE_TRUNC('year', created_at - INTERVAL '3 months') + INTERVAL '3 months')"},
+		{PayCycle(10), "(DATE_TRUNC('month', created_at - INTERVAL '9 days') + INTERVAL '9 days')"},
+		{PayCycle(31), "(DATE_TRUNC('month', created_at - INTERVAL '27 days') + INTERVAL '27 days')"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.period.SQLBucket("created_at"); got != tt.expected {
+			t.Errorf("%s: expected %q, got %q", tt.period, tt.expected, got)
+		}
+	}
+}
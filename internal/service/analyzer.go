@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
 	"sort"
 	"time"
 
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/cadence"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
 )
 
@@ -16,6 +19,7 @@ type AnalyzerService struct {
 	storage storage.TransactionStorage
 	logger  *slog.Logger
 	cfg     *config.AnalyticsConfig
+	now     func() time.Time
 }
 
 func NewAnalyzerService(storage storage.TransactionStorage, logger *slog.Logger, cfg *config.AnalyticsConfig) *AnalyzerService {
@@ -23,10 +27,14 @@ func NewAnalyzerService(storage storage.TransactionStorage, logger *slog.Logger,
 		storage: storage,
 		logger:  logger.With("component", "analyzer_service"),
 		cfg:     cfg,
+		now:     time.Now,
 	}
 }
 
-func (s *AnalyzerService) GetStatistics(ctx context.Context, userID string, startDate, endDate time.Time, groupBy models.TimePeriod) ([]models.PeriodStats, int64, int64, error) {
+// GetStatistics returns the periods of the range that have transactions. With
+// fillGaps it returns every period in the range, with explicit zero periods
+// where there were no transactions.
+func (s *AnalyzerService) GetStatistics(ctx context.Context, userID string, startDate, endDate time.Time, groupBy models.TimePeriod, fillGaps bool) ([]models.PeriodStats, int64, int64, error) {
 	if userID == "" {
 		return nil, 0, 0, fmt.Errorf("user_id is required")
 	}
@@ -43,11 +51,16 @@ func (s *AnalyzerService) GetStatistics(ctx context.Context, userID string, star
 		groupBy = models.TimePeriodMonth
 	}
 
+	period, err := s.resolvePeriod(ctx, userID, groupBy)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
 	req := storage.GetStatisticsRequest{
 		UserID:    userID,
 		StartDate: startDate,
 		EndDate:   endDate,
-		GroupBy:   groupBy,
+		Period:    period,
 	}
 
 	periods, err := s.storage.GetStatistics(ctx, req)
@@ -56,6 +69,10 @@ func (s *AnalyzerService) GetStatistics(ctx context.Context, userID string, star
 		return nil, 0, 0, fmt.Errorf("failed to get statistics: %w", err)
 	}
 
+	if fillGaps {
+		periods = padPeriodRange(fillPeriodGaps(periods, period), startDate, endDate, period)
+	}
+
 	totalIncome := int64(0)
 	totalExpense := int64(0)
 
@@ -74,7 +91,7 @@ func (s *AnalyzerService) GetStatistics(ctx context.Context, userID string, star
 	return periods, totalIncome, totalExpense, nil
 }
 
-func (s *AnalyzerService) GetForecast(ctx context.Context, userID string, period models.TimePeriod, periodsAhead int) ([]models.PeriodStats, error) {
+func (s *AnalyzerService) GetForecast(ctx context.Context, userID string, unit models.TimePeriod, periodsAhead int, method string) (*models.ForecastResult, error) {
 	if userID == "" {
 		return nil, fmt.Errorf("user_id is required")
 	}
@@ -88,206 +105,309 @@ func (s *AnalyzerService) GetForecast(ctx context.Context, userID string, period
 		return nil, fmt.Errorf("periods_ahead cannot exceed %d", maxPeriodsAhead)
 	}
 
-	if period == "" {
-		period = models.TimePeriodMonth
+	if unit == "" {
+		unit = models.TimePeriodMonth
+	}
+
+	if method == "" {
+		method = s.cfg.Forecast.Method
+	}
+	if method == "" {
+		method = ForecastMethodAuto
+	}
+	if err := validateForecastMethod(method); err != nil {
+		return nil, err
+	}
+
+	period, err := s.resolvePeriod(ctx, userID, unit)
+	if err != nil {
+		return nil, err
 	}
 
-	lookbackPeriods := s.cfg.Forecast.LookbackPeriods
-	now := time.Now()
-	currentPeriodStart := truncateToPeriodStart(now, period)
-	startDate := calculateStartDate(currentPeriodStart, period, lookbackPeriods)
+	lookbackPeriods := s.forecastLookbackPeriods(method, period)
+	fetchPeriods := partialPeriodLookback(s.cfg.Forecast.PartialPeriod, lookbackPeriods)
+	now := s.now()
+	currentPeriodStart := period.Truncate(now)
+	startDate := period.Add(currentPeriodStart, -lookbackPeriods)
 
-	historicalData, err := s.storage.GetTransactionsForForecast(ctx, userID, startDate, lookbackPeriods, period)
+	historicalData, err := s.storage.GetTransactionsForForecast(ctx, storage.GetTransactionsForForecastRequest{
+		UserID:    userID,
+		StartDate: startDate,
+		Periods:   fetchPeriods,
+		Period:    period,
+	})
 	if err != nil {
 		s.logger.Error("failed to get historical data", "error", err, "user_id", userID)
 		return nil, fmt.Errorf("failed to get historical data: %w", err)
 	}
 
+	historicalData = denseHistory(historicalData, startDate, currentPeriodStart, period)
+
+	scale, keep := partialPeriodScale(s.cfg.Forecast.PartialPeriod, now, period)
+	historicalData = adjustPartialPeriod(historicalData, currentPeriodStart, scale, keep, lookbackPeriods)
+
 	if len(historicalData) < 2 {
 		return nil, fmt.Errorf("insufficient historical data for forecast (need at least 2 periods)")
 	}
 
-	forecasts := s.calculateWMAForecast(historicalData, periodsAhead, period)
-
-	s.logger.Info("forecast calculated",
-		"user_id", userID,
-		"periods_ahead", periodsAhead,
-		"historical_periods", len(historicalData),
-	)
-
-	return forecasts, nil
-}
-
-func (s *AnalyzerService) calculateWMAForecast(historical []models.PeriodStats, periodsAhead int, period models.TimePeriod) []models.PeriodStats {
-	n := len(historical)
-	if n > 6 {
-		n = 6
+	categoryStats, err := s.storage.GetCategoryStatsByPeriods(ctx, storage.GetCategoryStatsByPeriodsRequest{
+		UserID:    userID,
+		StartDate: startDate,
+		Periods:   fetchPeriods,
+		Period:    period,
+	})
+	if err != nil {
+		s.logger.Error("failed to get category stats", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get category stats: %w", err)
 	}
+	categoryStats = adjustPartialCategoryStats(categoryStats, currentPeriodStart, scale, keep)
 
-	weights := make([]float64, n)
-	totalWeight := 0.0
-	for i := 0; i < n; i++ {
-		weights[i] = float64(n - i)
-		totalWeight += weights[i]
+	var committed *committedExpenses
+	if s.cfg.Forecast.Hybrid.Enabled {
+		patterns, err := s.storage.GetRecurringPatterns(ctx, userID)
+		if err != nil {
+			s.logger.Error("failed to get recurring patterns", "error", err, "user_id", userID)
+			return nil, fmt.Errorf("failed to get recurring patterns: %w", err)
+		}
+		committed = s.splitCommittedExpenses(historicalData, categoryStats, patterns, period, periodsAhead, now)
 	}
 
-	avgIncome := 0.0
-	avgExpense := 0.0
-
-	for i := 0; i < n; i++ {
-		weight := weights[i] / totalWeight
-		avgIncome += float64(historical[i].Income) * weight
-		avgExpense += float64(historical[i].Expense) * weight
-	}
+	forecaster := s.selectForecaster(method, period, len(historicalData))
+	forecasts := s.calculateForecast(forecaster, historicalData, periodsAhead, period, committed)
 
-	forecasts := make([]models.PeriodStats, periodsAhead)
-	lastPeriod := historical[0].PeriodStart
+	s.forecastCategories(historicalData, categoryStats, forecasts, forecaster)
 
-	for i := 0; i < periodsAhead; i++ {
-		periodStart := calculateNextPeriod(lastPeriod, period, i+1)
-		periodEnd := calculatePeriodEnd(periodStart, period)
+	incomes, expenses := chronologicalSeries(historicalData)
+	incomeTrend := seriesTrend(incomes)
+	expenseTrend := seriesTrend(expenses)
 
-		forecasts[i] = models.PeriodStats{
-			PeriodStart: periodStart,
-			PeriodEnd:   periodEnd,
-			Income:      int64(avgIncome),
-			Expense:     int64(avgExpense),
-			Balance:     int64(avgIncome) - int64(avgExpense),
-			Categories:  []models.CategoryStats{},
-		}
-	}
+	s.logger.Info("forecast calculated",
+		"user_id", userID,
+		"requested_method", method,
+		"method", forecaster.Name(),
+		"periods_ahead", periodsAhead,
+		"historical_periods", len(historicalData),
+		"categories", len(categoryStats),
+		"hybrid", committed != nil,
+		"income_slope_percent", incomeTrend.SlopePercent,
+		"expense_slope_percent", expenseTrend.SlopePercent,
+	)
 
-	return forecasts
+	return &models.ForecastResult{
+		Method:       forecaster.Name(),
+		Forecasts:    forecasts,
+		IncomeTrend:  incomeTrend,
+		ExpenseTrend: expenseTrend,
+	}, nil
 }
 
-func truncateToPeriodStart(t time.Time, period models.TimePeriod) time.Time {
-	year, month, _ := t.Date()
-	switch period {
-	case models.TimePeriodMonth:
-		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
-	case models.TimePeriodQuarter:
-		quarterMonth := ((int(month)-1)/3)*3 + 1
-		return time.Date(year, time.Month(quarterMonth), 1, 0, 0, 0, 0, t.Location())
-	case models.TimePeriodYear:
-		return time.Date(year, 1, 1, 0, 0, 0, 0, t.Location())
+// resolvePeriod completes a period type with the user's anchors: the pay
+// day for PAY_CYCLE and the configured start month for FISCAL_YEAR.
+func (s *AnalyzerService) resolvePeriod(ctx context.Context, userID string, unit models.TimePeriod) (period.Period, error) {
+	switch unit {
+	case models.TimePeriodPayCycle:
+		anchorDay, err := s.payCycleAnchorDay(ctx, userID)
+		if err != nil {
+			return period.Period{}, err
+		}
+		return period.PayCycle(anchorDay), nil
+	case models.TimePeriodFiscalYear:
+		return period.FiscalYear(time.Month(s.cfg.FiscalYear.StartMonth)), nil
 	default:
-		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+		return period.New(unit), nil
 	}
 }
 
-func calculateStartDate(periodStart time.Time, period models.TimePeriod, lookbackPeriods int) time.Time {
-	switch period {
-	case models.TimePeriodMonth:
-		return periodStart.AddDate(0, -lookbackPeriods, 0)
-	case models.TimePeriodQuarter:
-		return periodStart.AddDate(0, -lookbackPeriods*3, 0)
-	case models.TimePeriodYear:
-		return periodStart.AddDate(-lookbackPeriods, 0, 0)
-	default:
-		return periodStart.AddDate(0, -lookbackPeriods, 0)
+// GetAnomalies analyzes the period containing periodStart against the
+// periods before it. A zero periodStart analyzes the latest period with data.
+// It also returns the thresholds resolved for the user.
+func (s *AnalyzerService) GetAnomalies(ctx context.Context, userID string, unit models.TimePeriod, periodStart time.Time) ([]models.CategoryAnomaly, *models.AnomalyThresholds, error) {
+	if userID == "" {
+		return nil, nil, fmt.Errorf("user_id is required")
 	}
-}
 
-func calculateNextPeriod(base time.Time, period models.TimePeriod, offset int) time.Time {
-	switch period {
-	case models.TimePeriodMonth:
-		return base.AddDate(0, offset, 0)
-	case models.TimePeriodQuarter:
-		return base.AddDate(0, offset*3, 0)
-	case models.TimePeriodYear:
-		return base.AddDate(offset, 0, 0)
-	default:
-		return base.AddDate(0, offset, 0)
+	if unit == "" {
+		unit = models.TimePeriodMonth
 	}
-}
 
-func calculatePeriodEnd(start time.Time, period models.TimePeriod) time.Time {
-	switch period {
-	case models.TimePeriodMonth:
-		return start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	case models.TimePeriodQuarter:
-		return start.AddDate(0, 3, 0).Add(-time.Nanosecond)
-	case models.TimePeriodYear:
-		return start.AddDate(1, 0, 0).Add(-time.Nanosecond)
-	default:
-		return start.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	scoring := s.cfg.Anomaly.Scoring
+	if err := validateAnomalyScoring(scoring); err != nil {
+		return nil, nil, err
 	}
-}
 
-func (s *AnalyzerService) GetAnomalies(ctx context.Context, userID string, period models.TimePeriod) ([]models.CategoryAnomaly, error) {
-	if userID == "" {
-		return nil, fmt.Errorf("user_id is required")
+	period, err := s.resolvePeriod(ctx, userID, unit)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if period == "" {
-		period = models.TimePeriodMonth
-	}
+	lookbackPeriods := s.anomalyLookbackPeriods(period)
+	now := s.now()
+	currentStart := period.Truncate(now)
+	scale, keep := partialPeriodScale(s.cfg.Anomaly.PartialPeriod, now, period)
 
-	lookbackPeriods := s.cfg.Anomaly.LookbackPeriods
-	now := time.Now()
-	startDate := calculateStartDate(now, period, lookbackPeriods)
+	anchor := now
+	var target, endDate time.Time
+	if !periodStart.IsZero() {
+		target = period.Truncate(periodStart)
+		if target.After(currentStart) {
+			return nil, nil, fmt.Errorf("period_start cannot be in the future")
+		}
+		if target.Equal(currentStart) && !keep {
+			return nil, nil, fmt.Errorf("period_start is in the current period, which is excluded by the partial period mode")
+		}
+		anchor = target
+		endDate = period.Next(target)
+	}
+	startDate := period.Add(anchor, -lookbackPeriods)
 
 	s.logger.Info("GetAnomalies started",
 		"user_id", userID,
 		"period", period,
 		"lookback_periods", lookbackPeriods,
 		"start_date", startDate,
+		"end_date", endDate,
+		"target_period", target,
 		"now", now,
+		"partial_period_scale", scale,
+		"partial_period_kept", keep,
 	)
 
-	stats, err := s.storage.GetCategoryStatsByPeriods(ctx, userID, startDate, lookbackPeriods, period)
-	if err != nil {
-		s.logger.Error("failed to get category stats", "error", err, "user_id", userID)
-		return nil, fmt.Errorf("failed to get category stats: %w", err)
+	dataByFlow := make(map[models.TransactionType]map[time.Time]map[string]int64)
+	periodSet := make(map[time.Time]bool)
+	for _, flowType := range []models.TransactionType{models.TransactionTypeExpense, models.TransactionTypeIncome} {
+		stats, err := s.storage.GetCategoryStatsByPeriods(ctx, storage.GetCategoryStatsByPeriodsRequest{
+			UserID:    userID,
+			StartDate: startDate,
+			EndDate:   endDate,
+			Periods:   lookbackPeriods,
+			Period:    period,
+			Type:      flowType,
+		})
+		if err != nil {
+			s.logger.Error("failed to get category stats", "error", err, "user_id", userID, "type", flowType)
+			return nil, nil, fmt.Errorf("failed to get category stats: %w", err)
+		}
+
+		stats = adjustPartialCategoryStats(stats, currentStart, scale, keep)
+
+		s.logger.Info("category stats retrieved", "type", flowType, "stats_count", len(stats))
+
+		periodData := make(map[time.Time]map[string]int64)
+		for _, stat := range stats {
+			if _, exists := periodData[stat.PeriodStart]; !exists {
+				periodData[stat.PeriodStart] = make(map[string]int64)
+			}
+			periodData[stat.PeriodStart][stat.CategoryID] = stat.Amount
+			periodSet[stat.PeriodStart] = true
+		}
+		dataByFlow[flowType] = periodData
 	}
 
-	s.logger.Info("category stats retrieved", "stats_count", len(stats))
+	// A target period is analyzed even without data of its own; every
+	// category then reads as a drop against its baseline.
+	if !target.IsZero() {
+		periodSet[target] = true
+	}
+
+	s.logger.Info("periods data grouped", "unique_periods", len(periodSet))
+
+	if len(periodSet) < 2 {
+		s.logger.Warn("insufficient periods for anomaly detection", "periods_count", len(periodSet))
+		return nil, nil, fmt.Errorf("insufficient data for anomaly detection (need at least 2 periods)")
+	}
 
-	periodData := make(map[time.Time]map[string]int64)
-	for _, stat := range stats {
-		if _, exists := periodData[stat.PeriodStart]; !exists {
-			periodData[stat.PeriodStart] = make(map[string]int64)
+	// The analyzed period is shared by both flows, so a salary missing from
+	// it still shows up as an income drop.
+	var analyzedPeriod time.Time
+	for p := range periodSet {
+		if p.After(analyzedPeriod) {
+			analyzedPeriod = p
 		}
-		periodData[stat.PeriodStart][stat.CategoryID] = stat.Amount
 	}
 
-	periods := make([]time.Time, 0, len(periodData))
-	for p := range periodData {
-		periods = append(periods, p)
+	s.logger.Info("analyzed period selected", "period", analyzedPeriod)
+
+	thresholds, err := s.anomalyThresholds(ctx, userID, period, dataByFlow, periodSet, analyzedPeriod)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	s.logger.Info("periods data grouped", "unique_periods", len(periods))
+	feedback, err := s.anomalyFeedback(ctx, userID, period, analyzedPeriod)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if len(periods) < 2 {
-		s.logger.Warn("insufficient periods for anomaly detection", "periods_count", len(periods))
-		return nil, fmt.Errorf("insufficient data for anomaly detection (need at least 2 periods)")
+	// A prorated amount of zero is still zero, so in the unfinished current
+	// period a salary or rent not yet paid would read as a drop.
+	partial := !analyzedPeriod.Before(currentStart)
+
+	var anomalies []models.CategoryAnomaly
+	anomalies = append(anomalies, s.detectCategoryAnomalies(period, models.TransactionTypeExpense, dataByFlow[models.TransactionTypeExpense], analyzedPeriod, partial, thresholds, feedback)...)
+	anomalies = append(anomalies, s.detectCategoryAnomalies(period, models.TransactionTypeIncome, dataByFlow[models.TransactionTypeIncome], analyzedPeriod, partial, thresholds, feedback)...)
+	anomalies = feedback.apply(anomalies)
+
+	if err := s.attachTopTransactions(ctx, userID, period, analyzedPeriod, anomalies); err != nil {
+		return nil, nil, err
 	}
 
-	sort.Slice(periods, func(i, j int) bool {
-		return periods[i].After(periods[j])
+	sort.Slice(anomalies, func(i, j int) bool {
+		return math.Abs(float64(anomalies[i].DeviationAmount)) > math.Abs(float64(anomalies[j].DeviationAmount))
 	})
 
-	analyzedPeriod := periods[0]
-	actualByCategory := periodData[analyzedPeriod]
-
-	s.logger.Info("analyzed period selected",
-		"period", analyzedPeriod,
-		"categories_count", len(actualByCategory),
+	s.logger.Info("anomalies detected",
+		"user_id", userID,
+		"period", period,
+		"anomalies_count", len(anomalies),
 	)
 
-	historicalPeriods := periods[1:]
-	if len(historicalPeriods) > 5 {
-		historicalPeriods = historicalPeriods[:5]
+	return anomalies, thresholds, nil
+}
+
+// detectCategoryAnomalies compares one flow's categories in analyzedPeriod
+// against up to five earlier periods, blended with the same period in
+// previous years when the seasonal baseline applies. Expenses are flagged in
+// both directions, income only when it falls short of expected; drops are
+// only reported for a completed period. The user's thresholds are raised for
+// categories they keep acknowledging.
+func (s *AnalyzerService) detectCategoryAnomalies(period period.Period, flowType models.TransactionType, periodData map[time.Time]map[string]int64, analyzedPeriod time.Time, partial bool, thresholds *models.AnomalyThresholds, feedback *anomalyFeedback) []models.CategoryAnomaly {
+	scoring := s.cfg.Anomaly.Scoring
+
+	var earliest time.Time
+	for p := range periodData {
+		if p.Before(analyzedPeriod) && (earliest.IsZero() || p.Before(earliest)) {
+			earliest = p
+		}
+	}
+
+	if earliest.IsZero() {
+		s.logger.Info("no history for anomaly detection", "type", flowType)
+		return nil
+	}
+
+	// Periods are counted back from analyzedPeriod, so a period without any
+	// data in the flow is a zero rather than being skipped.
+	historicalPeriods := make([]time.Time, 0, 5)
+	for i := 1; i <= 5; i++ {
+		p := period.Add(analyzedPeriod, -i)
+		if p.Before(earliest) {
+			break
+		}
+		historicalPeriods = append(historicalPeriods, p)
 	}
 
 	s.logger.Info("historical periods selected",
+		"type", flowType,
 		"count", len(historicalPeriods),
 		"periods", historicalPeriods,
 	)
 
+	actualByCategory := periodData[analyzedPeriod]
 	expectedByCategory := s.calculateWMAByCategory(periodData, historicalPeriods)
 
-	s.logger.Info("WMA forecast calculated", "categories_with_forecast", len(expectedByCategory))
+	s.logger.Info("WMA forecast calculated", "type", flowType, "categories_with_forecast", len(expectedByCategory))
+
+	recentWeights := wmaWeights(len(historicalPeriods))
+	seasonal := s.seasonalPeriods(period, analyzedPeriod)
 
 	var anomalies []models.CategoryAnomaly
 
@@ -298,28 +418,54 @@ func (s *AnalyzerService) GetAnomalies(ctx context.Context, userID string, perio
 	for cat := range expectedByCategory {
 		allCategories[cat] = true
 	}
+	for _, p := range seasonal {
+		for cat := range periodData[p] {
+			allCategories[cat] = true
+		}
+	}
 
 	for categoryID := range allCategories {
 		actual := actualByCategory[categoryID]
 		expected := expectedByCategory[categoryID]
+		baseline := anomalyBaseline(periodData, historicalPeriods, recentWeights, categoryID)
+		baselinePeriods := historicalPeriods
+
+		if yoy := categorySeasonalPeriods(periodData, seasonal, categoryID); len(yoy) > 0 {
+			baseline = blendSeasonalBaseline(baseline, anomalyBaseline(periodData, yoy, evenWeights(len(yoy)), categoryID), s.seasonalWeight())
+			baselinePeriods = append(baselinePeriods[:len(baselinePeriods):len(baselinePeriods)], yoy...)
+			expected = baselineExpectation(baseline)
+		}
 
 		s.logger.Debug("checking category",
+			"type", flowType,
 			"mcc", categoryID,
 			"actual", actual,
 			"expected", expected,
 		)
 
-		newCategoryThreshold := s.cfg.Anomaly.NewCategoryThreshold
-		if expected == 0 && actual > newCategoryThreshold {
+		multiplier := feedback.thresholdMultiplier(flowType, categoryID)
+
+		newCategoryThreshold := int64(float64(thresholds.NewCategoryThreshold) * multiplier)
+		if flowType == models.TransactionTypeExpense && expected == 0 && actual > newCategoryThreshold {
+			score := newCategoryScore(scoring.Thresholds, actual, newCategoryThreshold)
+			severity, _ := anomalySeverity(scoring.Thresholds, score)
 			s.logger.Info("new category anomaly detected",
 				"mcc", categoryID,
 				"actual", actual,
+				"score", score,
+				"severity", severity,
 			)
 			anomalies = append(anomalies, models.CategoryAnomaly{
 				MCC:             categoryID,
+				FlowType:        flowType,
+				Direction:       models.AnomalyDirectionAbove,
 				ActualAmount:    actual,
 				ExpectedAmount:  0,
 				DeviationAmount: actual,
+				Score:           score,
+				Severity:        severity,
+				PeriodStart:     analyzedPeriod,
+				Baseline:        baseline,
 			})
 			continue
 		}
@@ -331,35 +477,68 @@ func (s *AnalyzerService) GetAnomalies(ctx context.Context, userID string, perio
 		deviation := actual - expected
 		deviationPercent := (float64(deviation) / float64(expected)) * 100
 
-		deviationThreshold := s.cfg.Anomaly.DeviationThreshold
-		if deviationPercent > deviationThreshold {
-			s.logger.Info("anomaly detected",
-				"mcc", categoryID,
-				"actual", actual,
-				"expected", expected,
-				"deviation", deviation,
-				"deviation_percent", deviationPercent,
-			)
-			anomalies = append(anomalies, models.CategoryAnomaly{
-				MCC:             categoryID,
-				ActualAmount:    actual,
-				ExpectedAmount:  expected,
-				DeviationAmount: deviation,
-			})
+		direction := models.AnomalyDirectionAbove
+		if deviation < 0 {
+			direction = models.AnomalyDirectionBelow
+		}
+		if flowType == models.TransactionTypeIncome && direction == models.AnomalyDirectionAbove {
+			continue
+		}
+		// A missing one-off purchase is not a drop, and neither is a payment
+		// the unfinished period may still bring.
+		if direction == models.AnomalyDirectionBelow && (partial || !regularInBaseline(periodData, historicalPeriods, categoryID)) {
+			continue
 		}
-	}
 
-	sort.Slice(anomalies, func(i, j int) bool {
-		return anomalies[i].DeviationAmount > anomalies[j].DeviationAmount
-	})
+		deviationThreshold := thresholds.DeviationThreshold * multiplier
+		if math.Abs(deviationPercent) <= deviationThreshold {
+			continue
+		}
 
-	s.logger.Info("anomalies detected",
-		"user_id", userID,
-		"period", period,
-		"anomalies_count", len(anomalies),
-	)
+		score := anomalyScore(scoring, actual, expected, categoryHistory(periodData, baselinePeriods, categoryID))
+		severity, ok := anomalySeverity(scoring.Thresholds, math.Abs(score))
+		if !ok {
+			continue
+		}
 
-	return anomalies, nil
+		s.logger.Info("anomaly detected",
+			"type", flowType,
+			"mcc", categoryID,
+			"direction", direction,
+			"actual", actual,
+			"expected", expected,
+			"deviation", deviation,
+			"deviation_percent", deviationPercent,
+			"score", score,
+			"severity", severity,
+		)
+		anomalies = append(anomalies, models.CategoryAnomaly{
+			MCC:             categoryID,
+			FlowType:        flowType,
+			Direction:       direction,
+			ActualAmount:    actual,
+			ExpectedAmount:  expected,
+			DeviationAmount: deviation,
+			Score:           score,
+			Severity:        severity,
+			PeriodStart:     analyzedPeriod,
+			Baseline:        baseline,
+		})
+	}
+
+	return anomalies
+}
+
+// regularInBaseline reports whether the category had data in more than half
+// of the periods.
+func regularInBaseline(periodData map[time.Time]map[string]int64, periods []time.Time, categoryID string) bool {
+	present := 0
+	for _, p := range periods {
+		if periodData[p][categoryID] != 0 {
+			present++
+		}
+	}
+	return present*2 > len(periods)
 }
 
 func (s *AnalyzerService) calculateWMAByCategory(periodData map[time.Time]map[string]int64, periods []time.Time) map[string]int64 {
@@ -368,16 +547,7 @@ func (s *AnalyzerService) calculateWMAByCategory(periodData map[time.Time]map[st
 		return make(map[string]int64)
 	}
 
-	weights := make([]float64, n)
-	totalWeight := 0.0
-	for i := 0; i < n; i++ {
-		weights[i] = float64(n - i)
-		totalWeight += weights[i]
-	}
-
-	for i := range weights {
-		weights[i] /= totalWeight
-	}
+	weights := wmaWeights(n)
 
 	allCategories := make(map[string]bool)
 	for _, period := range periods {
@@ -417,16 +587,21 @@ func (s *AnalyzerService) GetUpcomingRecurring(ctx context.Context, userID strin
 
 	s.logger.Info("recurring patterns retrieved", "patterns_count", len(patterns))
 
-	now := time.Now()
+	now := s.now()
 	predictionWindow := now.AddDate(0, 0, s.cfg.Recurring.PredictionDays)
 
 	var payments []models.RecurringPayment
 
 	for _, pattern := range patterns {
 		expectedDate := pattern.LastOccurrence.AddDate(0, 0, int(pattern.AvgIntervalDays))
+		if pattern.Cadence != "" {
+			expectedDate = cadence.Next(pattern.Cadence, pattern.LastOccurrence)
+		}
 
 		s.logger.Debug("checking pattern",
 			"mcc", pattern.MCC,
+			"merchant", pattern.Merchant,
+			"cadence", pattern.Cadence,
 			"last_occurrence", pattern.LastOccurrence,
 			"expected_date", expectedDate,
 			"avg_interval_days", pattern.AvgIntervalDays,
@@ -435,11 +610,15 @@ func (s *AnalyzerService) GetUpcomingRecurring(ctx context.Context, userID strin
 		if expectedDate.After(now) && expectedDate.Before(predictionWindow) {
 			s.logger.Info("upcoming payment detected",
 				"mcc", pattern.MCC,
+				"merchant", pattern.Merchant,
+				"cadence", pattern.Cadence,
 				"expected_date", expectedDate,
 				"typical_amount", pattern.MedianAmount,
 			)
 			payments = append(payments, models.RecurringPayment{
 				MCC:           pattern.MCC,
+				Merchant:      pattern.Merchant,
+				Cadence:       pattern.Cadence,
 				TypicalAmount: pattern.MedianAmount,
 				ExpectedDate:  expectedDate,
 			})
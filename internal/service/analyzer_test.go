@@ -9,14 +9,24 @@ import (
 
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
 )
 
 func getDefaultTestConfig() *config.AnalyticsConfig {
 	return &config.AnalyticsConfig{
 		Forecast: config.ForecastConfig{
+			Method:          "auto",
 			LookbackPeriods: 6,
 			MaxPeriodsAhead: 12,
+			Window:          6,
+			Exponential: config.ExponentialForecastConfig{
+				Alpha: 0.3,
+			},
+			Holt: config.HoltForecastConfig{
+				Alpha: 0.5,
+				Beta:  0.3,
+			},
 		},
 		Anomaly: config.AnomalyConfig{
 			LookbackPeriods:      6,
@@ -31,6 +41,11 @@ func getDefaultTestConfig() *config.AnalyticsConfig {
 			DateDeviationDays: 3,
 			PredictionDays:    30,
 		},
+		CashFlow: config.CashFlowConfig{
+			HorizonDays:    30,
+			MaxHorizonDays: 90,
+			LookbackMonths: 3,
+		},
 	}
 }
 
@@ -77,6 +92,7 @@ func TestGetStatistics_Success(t *testing.T) {
 		startDate,
 		endDate,
 		models.TimePeriodMonth,
+		false,
 	)
 
 	if err != nil {
@@ -116,6 +132,7 @@ func TestGetStatistics_EmptyUserID(t *testing.T) {
 		time.Now(),
 		time.Now(),
 		models.TimePeriodMonth,
+		false,
 	)
 
 	if err == nil {
@@ -143,6 +160,7 @@ func TestGetStatistics_InvalidDateRange(t *testing.T) {
 		startDate,
 		endDate,
 		models.TimePeriodMonth,
+		false,
 	)
 
 	if err == nil {
@@ -167,6 +185,7 @@ func TestGetStatistics_ZeroDates(t *testing.T) {
 		time.Time{},
 		time.Time{},
 		models.TimePeriodMonth,
+		false,
 	)
 
 	if err == nil {
@@ -199,6 +218,7 @@ func TestGetStatistics_NoData(t *testing.T) {
 		startDate,
 		endDate,
 		models.TimePeriodMonth,
+		false,
 	)
 
 	if err != nil {
@@ -223,7 +243,7 @@ func TestGetForecast_Success(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
 		return []models.PeriodStats{
 			{
 				PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
@@ -250,18 +270,22 @@ func TestGetForecast_Success(t *testing.T) {
 	}
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC) }
 
-	forecasts, err := service.GetForecast(
+	result, err := service.GetForecast(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
 		3,
+		"",
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
+	forecasts := result.Forecasts
+
 	if len(forecasts) != 3 {
 		t.Fatalf("expected 3 forecast periods, got %d", len(forecasts))
 	}
@@ -299,6 +323,7 @@ func TestGetForecast_EmptyUserID(t *testing.T) {
 		"",
 		models.TimePeriodMonth,
 		3,
+		"",
 	)
 
 	if err == nil {
@@ -311,7 +336,7 @@ func TestGetForecast_InsufficientData(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
 		return []models.PeriodStats{
 			{
 				PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -324,12 +349,14 @@ func TestGetForecast_InsufficientData(t *testing.T) {
 	}
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC) }
 
 	_, err := service.GetForecast(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
 		3,
+		"",
 	)
 
 	if err == nil {
@@ -353,6 +380,7 @@ func TestGetForecast_TooManyPeriodsAhead(t *testing.T) {
 		"user-123",
 		models.TimePeriodMonth,
 		13,
+		"",
 	)
 
 	if err == nil {
@@ -370,26 +398,30 @@ func TestGetForecast_ZeroPeriodsAhead(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
 		return []models.PeriodStats{
-			{Income: 100000, Expense: 50000},
-			{Income: 95000, Expense: 48000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 95000, Expense: 48000},
 		}, nil
 	}
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
 
-	forecasts, err := service.GetForecast(
+	result, err := service.GetForecast(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
 		0,
+		"",
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
+	forecasts := result.Forecasts
+
 	if len(forecasts) != 1 {
 		t.Errorf("expected 1 forecast (default), got %d", len(forecasts))
 	}
@@ -419,7 +451,7 @@ func TestCalculateWMAForecast_WeightedCorrectly(t *testing.T) {
 		},
 	}
 
-	forecasts := service.calculateWMAForecast(historical, 2, models.TimePeriodMonth)
+	forecasts := service.calculateForecast(newWMAForecaster(6), historical, 2, period.New(models.TimePeriodMonth), nil)
 
 	if len(forecasts) != 2 {
 		t.Fatalf("expected 2 forecasts, got %d", len(forecasts))
@@ -458,7 +490,7 @@ func TestCalculateWMAForecast_MaxSixPeriods(t *testing.T) {
 		}
 	}
 
-	forecasts := service.calculateWMAForecast(historical, 1, models.TimePeriodMonth)
+	forecasts := service.calculateForecast(newWMAForecaster(6), historical, 1, period.New(models.TimePeriodMonth), nil)
 
 	if len(forecasts) != 1 {
 		t.Fatalf("expected 1 forecast, got %d", len(forecasts))
@@ -474,29 +506,33 @@ func TestGetForecast_QuarterlyPeriod(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
-		if groupBy != models.TimePeriodQuarter {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period.Unit != models.TimePeriodQuarter {
 			t.Error("expected TimePeriodQuarter")
 		}
 		return []models.PeriodStats{
-			{Income: 300000, Expense: 150000},
-			{Income: 280000, Expense: 140000},
+			{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Income: 300000, Expense: 150000},
+			{PeriodStart: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), Income: 280000, Expense: 140000},
 		}, nil
 	}
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
 
-	forecasts, err := service.GetForecast(
+	result, err := service.GetForecast(
 		context.Background(),
 		"user-123",
 		models.TimePeriodQuarter,
 		2,
+		"",
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
+	forecasts := result.Forecasts
+
 	if len(forecasts) != 2 {
 		t.Fatalf("expected 2 forecasts, got %d", len(forecasts))
 	}
@@ -507,29 +543,33 @@ func TestGetForecast_YearlyPeriod(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
-		if groupBy != models.TimePeriodYear {
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period.Unit != models.TimePeriodYear {
 			t.Error("expected TimePeriodYear")
 		}
 		return []models.PeriodStats{
-			{Income: 1200000, Expense: 600000},
-			{Income: 1150000, Expense: 580000},
+			{PeriodStart: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Income: 1200000, Expense: 600000},
+			{PeriodStart: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Income: 1150000, Expense: 580000},
 		}, nil
 	}
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
 
-	forecasts, err := service.GetForecast(
+	result, err := service.GetForecast(
 		context.Background(),
 		"user-123",
 		models.TimePeriodYear,
 		2,
+		"",
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
+	forecasts := result.Forecasts
+
 	if len(forecasts) != 2 {
 		t.Fatalf("expected 2 forecasts, got %d", len(forecasts))
 	}
@@ -540,7 +580,7 @@ func TestGetAnomalies_Success(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 		return []models.CategoryPeriodStats{
 			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 150000},
 			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
@@ -552,10 +592,11 @@ func TestGetAnomalies_Success(t *testing.T) {
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	anomalies, err := service.GetAnomalies(
+	anomalies, _, err := service.GetAnomalies(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err != nil {
@@ -584,16 +625,75 @@ func TestGetAnomalies_Success(t *testing.T) {
 	}
 }
 
+func TestGetAnomalies_TargetPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if !req.StartDate.Equal(time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)) || !req.EndDate.Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected lookback ending with March, got %v - %v", req.StartDate, req.EndDate)
+		}
+		if req.Type != models.TransactionTypeExpense {
+			return nil, nil
+		}
+
+		var stats []models.CategoryPeriodStats
+		for _, stat := range []models.CategoryPeriodStats{
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 300000},
+			{PeriodStart: march, CategoryID: "5411", Amount: 250000},
+			{PeriodStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
+			{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
+		} {
+			if stat.PeriodStart.Before(req.EndDate) {
+				stats = append(stats, stat)
+			}
+		}
+		return stats, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	anomalies, _, err := service.GetAnomalies(
+		context.Background(),
+		"user-123",
+		models.TimePeriodMonth,
+		time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 || !anomalies[0].PeriodStart.Equal(march) || anomalies[0].ActualAmount != 250000 {
+		t.Errorf("expected the March spike, got %+v", anomalies)
+	}
+
+	_, _, err = service.GetAnomalies(
+		context.Background(),
+		"user-123",
+		models.TimePeriodMonth,
+		time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	if err == nil {
+		t.Error("expected error for a future period")
+	}
+}
+
 func TestGetAnomalies_EmptyUserID(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := getDefaultTestConfig()
 	mockStorage := storage.NewMockStorage()
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	_, err := service.GetAnomalies(
+	_, _, err := service.GetAnomalies(
 		context.Background(),
 		"",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err == nil {
@@ -606,7 +706,7 @@ func TestGetAnomalies_InsufficientData(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 		return []models.CategoryPeriodStats{
 			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
 		}, nil
@@ -614,10 +714,11 @@ func TestGetAnomalies_InsufficientData(t *testing.T) {
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	_, err := service.GetAnomalies(
+	_, _, err := service.GetAnomalies(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err == nil {
@@ -635,7 +736,7 @@ func TestGetAnomalies_NewCategory(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 		return []models.CategoryPeriodStats{
 			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
 			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 95000},
@@ -645,10 +746,11 @@ func TestGetAnomalies_NewCategory(t *testing.T) {
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	anomalies, err := service.GetAnomalies(
+	anomalies, _, err := service.GetAnomalies(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err != nil {
@@ -668,6 +770,9 @@ func TestGetAnomalies_NewCategory(t *testing.T) {
 			if a.DeviationAmount != 60000 {
 				t.Errorf("expected deviation amount 60000, got %d", a.DeviationAmount)
 			}
+			if a.Score != 2.4 || a.Severity != models.AnomalySeverityLow {
+				t.Errorf("expected score 2.4 and low severity, got %v %s", a.Score, a.Severity)
+			}
 		}
 	}
 
@@ -681,7 +786,7 @@ func TestGetAnomalies_BelowThreshold(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 		return []models.CategoryPeriodStats{
 			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 105000},
 			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
@@ -691,10 +796,11 @@ func TestGetAnomalies_BelowThreshold(t *testing.T) {
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	anomalies, err := service.GetAnomalies(
+	anomalies, _, err := service.GetAnomalies(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err != nil {
@@ -711,7 +817,7 @@ func TestGetAnomalies_SortedByDeviation(t *testing.T) {
 	cfg := getDefaultTestConfig()
 
 	mockStorage := storage.NewMockStorage()
-	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 		return []models.CategoryPeriodStats{
 			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 200000},
 			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 100000},
@@ -722,10 +828,11 @@ func TestGetAnomalies_SortedByDeviation(t *testing.T) {
 
 	service := NewAnalyzerService(mockStorage, logger, cfg)
 
-	anomalies, err := service.GetAnomalies(
+	anomalies, _, err := service.GetAnomalies(
 		context.Background(),
 		"user-123",
 		models.TimePeriodMonth,
+		time.Time{},
 	)
 
 	if err != nil {
@@ -743,6 +850,164 @@ func TestGetAnomalies_SortedByDeviation(t *testing.T) {
 	}
 }
 
+func TestGetAnomalies_SpendingDrop(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Type != models.TransactionTypeExpense {
+			return nil, nil
+		}
+		return []models.CategoryPeriodStats{
+			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 50000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 50000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 {
+		t.Fatalf("expected only the missing rent, got %+v", anomalies)
+	}
+	rent := anomalies[0]
+	if rent.MCC != "6513" || rent.Direction != models.AnomalyDirectionBelow || rent.FlowType != models.TransactionTypeExpense {
+		t.Errorf("expected rent expense below expected, got %+v", rent)
+	}
+	if rent.DeviationAmount >= 0 || rent.Score >= 0 {
+		t.Errorf("expected negative deviation and score, got %d %v", rent.DeviationAmount, rent.Score)
+	}
+	if rent.Severity != models.AnomalySeverityHigh {
+		t.Errorf("expected high severity for a missing fixed payment, got %s", rent.Severity)
+	}
+}
+
+func TestGetAnomalies_SporadicCategory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Type == models.TransactionTypeIncome {
+			return []models.CategoryPeriodStats{
+				{PeriodStart: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 200000},
+			}, nil
+		}
+		return []models.CategoryPeriodStats{
+			{PeriodStart: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5732", Amount: 30000},
+			{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+			{PeriodStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 7, 20, 0, 0, 0, 0, time.UTC) }
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, a := range anomalies {
+		if a.MCC == "5732" {
+			t.Errorf("expected a one-off purchase not to be reported as a drop, got %+v", a)
+		}
+	}
+}
+
+func TestGetAnomalies_IncomeDrop(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		switch req.Type {
+		case models.TransactionTypeIncome:
+			return []models.CategoryPeriodStats{
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 120000},
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6012", Amount: 5000},
+				{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 120000},
+				{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6012", Amount: 5000},
+				{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6012", Amount: 20000},
+			}, nil
+		default:
+			return []models.CategoryPeriodStats{
+				{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+			}, nil
+		}
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 {
+		t.Fatalf("expected only the missed salary (higher interest is not an alert), got %+v", anomalies)
+	}
+	salary := anomalies[0]
+	if salary.FlowType != models.TransactionTypeIncome || salary.Direction != models.AnomalyDirectionBelow {
+		t.Errorf("expected income below expected, got %+v", salary)
+	}
+	if salary.ActualAmount != 0 || salary.ExpectedAmount != 120000 {
+		t.Errorf("expected 0 of 120000, got %d of %d", salary.ActualAmount, salary.ExpectedAmount)
+	}
+}
+
+func TestGetAnomalies_SalaryNotYetPaid(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.PartialPeriod.Mode = PartialPeriodProrate
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		switch req.Type {
+		case models.TransactionTypeIncome:
+			return []models.CategoryPeriodStats{
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 300000},
+				{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 300000},
+				{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), CategoryID: "uncategorized", Amount: 300000},
+			}, nil
+		default:
+			return []models.CategoryPeriodStats{
+				{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 20000},
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+				{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 40000},
+				{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 50000},
+				{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 40000},
+			}, nil
+		}
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC) }
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, a := range anomalies {
+		if a.Direction == models.AnomalyDirectionBelow {
+			t.Errorf("expected no drop before the end of June, got %+v", a)
+		}
+	}
+}
+
 func TestGetUpcomingRecurring_Success(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := getDefaultTestConfig()
@@ -802,6 +1067,83 @@ func TestGetUpcomingRecurring_Success(t *testing.T) {
 	}
 }
 
+func TestGetUpcomingRecurring_Cadence(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetRecurringPatternsFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return []models.RecurringPattern{
+			{
+				MCC:             "6300",
+				Cadence:         models.RecurringCadenceQuarterly,
+				MedianAmount:    120000,
+				AvgIntervalDays: 92,
+				LastOccurrence:  time.Date(2024, 3, 25, 9, 0, 0, 0, time.UTC),
+			},
+			{
+				MCC:             "5734",
+				Cadence:         models.RecurringCadenceAnnual,
+				MedianAmount:    990000,
+				AvgIntervalDays: 365,
+				LastOccurrence:  time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC),
+			},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return now }
+
+	payments, err := service.GetUpcomingRecurring(
+		context.Background(),
+		"user-123",
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(payments) != 1 {
+		t.Fatalf("expected only the quarterly payment within 30 days, got %+v", payments)
+	}
+	if payments[0].Cadence != models.RecurringCadenceQuarterly || !payments[0].ExpectedDate.Equal(time.Date(2024, 6, 25, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the quarterly premium on June 25, got %+v", payments[0])
+	}
+}
+
+func TestGetUpcomingRecurring_SameMCCMerchants(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetRecurringPatternsFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return []models.RecurringPattern{
+			{MCC: "4899", Merchant: "NETFLIX.COM", Cadence: models.RecurringCadenceMonthly, MedianAmount: 79900, AvgIntervalDays: 30.5, LastOccurrence: time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC)},
+			{MCC: "4899", Merchant: "Spotify", Cadence: models.RecurringCadenceMonthly, MedianAmount: 29900, AvgIntervalDays: 30.4, LastOccurrence: time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC)},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return now }
+
+	payments, err := service.GetUpcomingRecurring(
+		context.Background(),
+		"user-123",
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(payments) != 2 || payments[0].Merchant != "NETFLIX.COM" || payments[1].Merchant != "Spotify" {
+		t.Errorf("expected both subscriptions by merchant, got %+v", payments)
+	}
+}
+
 func TestGetUpcomingRecurring_EmptyUserID(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cfg := getDefaultTestConfig()
@@ -1044,133 +1386,48 @@ func TestCalculateWMAByCategory_MissingCategoryInPeriod(t *testing.T) {
 	}
 }
 
-func TestTruncateToPeriodStart_Month(t *testing.T) {
-	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
-	expected := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
-	result := truncateToPeriodStart(input, models.TimePeriodMonth)
+func TestGetForecast_WeeklyPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
 
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
+	lastWeek := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
 
-func TestTruncateToPeriodStart_Quarter(t *testing.T) {
-	tests := []struct {
-		input    time.Time
-		expected time.Time
-	}{
-		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
-		{time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
-		{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
-		{time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
-		{time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
-		{time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
-	}
-
-	for _, tt := range tests {
-		result := truncateToPeriodStart(tt.input, models.TimePeriodQuarter)
-		if !result.Equal(tt.expected) {
-			t.Errorf("for input %v expected %v, got %v", tt.input, tt.expected, result)
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period.Unit != models.TimePeriodWeek {
+			t.Error("expected TimePeriodWeek")
 		}
+		if req.StartDate.Weekday() != time.Monday {
+			t.Errorf("expected lookback to start on Monday, got %v", req.StartDate.Weekday())
+		}
+		return []models.PeriodStats{
+			{PeriodStart: lastWeek, Income: 0, Expense: 12000},
+			{PeriodStart: lastWeek.AddDate(0, 0, -7), Income: 0, Expense: 9000},
+		}, nil
 	}
-}
-
-func TestTruncateToPeriodStart_Year(t *testing.T) {
-	input := time.Date(2024, 6, 15, 14, 30, 45, 0, time.UTC)
-	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := truncateToPeriodStart(input, models.TimePeriodYear)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
-
-func TestCalculateStartDate_Month(t *testing.T) {
-	periodStart := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateStartDate(periodStart, models.TimePeriodMonth, 3)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
-
-func TestCalculateStartDate_Quarter(t *testing.T) {
-	periodStart := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateStartDate(periodStart, models.TimePeriodQuarter, 2)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
-
-func TestCalculateStartDate_Year(t *testing.T) {
-	periodStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateStartDate(periodStart, models.TimePeriodYear, 2)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
-
-func TestCalculateNextPeriod_Month(t *testing.T) {
-	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateNextPeriod(base, models.TimePeriodMonth, 3)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
-	}
-}
 
-func TestCalculateNextPeriod_Quarter(t *testing.T) {
-	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateNextPeriod(base, models.TimePeriodQuarter, 2)
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC) }
 
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodWeek, 2, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
 	}
-}
 
-func TestCalculateNextPeriod_Year(t *testing.T) {
-	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	expected := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculateNextPeriod(base, models.TimePeriodYear, 2)
-
-	if !result.Equal(expected) {
-		t.Errorf("expected %v, got %v", expected, result)
+	first, second := result.Forecasts[0], result.Forecasts[1]
+	if !first.PeriodStart.Equal(time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected first forecast week to start 2024-06-17, got %v", first.PeriodStart)
 	}
-}
 
-func TestCalculatePeriodEnd_Month(t *testing.T) {
-	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculatePeriodEnd(start, models.TimePeriodMonth)
-
-	expected := time.Date(2024, 1, 31, 23, 59, 59, 999999999, time.UTC)
-	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
-		t.Errorf("expected %v, got %v", expected, result)
+	if !first.PeriodEnd.Equal(time.Date(2024, 6, 23, 23, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("expected first forecast week to end 2024-06-23, got %v", first.PeriodEnd)
 	}
-}
 
-func TestCalculatePeriodEnd_Quarter(t *testing.T) {
-	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculatePeriodEnd(start, models.TimePeriodQuarter)
-
-	expected := time.Date(2024, 3, 31, 23, 59, 59, 999999999, time.UTC)
-	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
-		t.Errorf("expected %v, got %v", expected, result)
+	if second.PeriodStart.Sub(first.PeriodStart) != 7*24*time.Hour {
+		t.Errorf("expected weekly step, got %v", second.PeriodStart.Sub(first.PeriodStart))
 	}
-}
-
-func TestCalculatePeriodEnd_Year(t *testing.T) {
-	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
-	result := calculatePeriodEnd(start, models.TimePeriodYear)
 
-	expected := time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)
-	if result.Year() != expected.Year() || result.Month() != expected.Month() || result.Day() != expected.Day() {
-		t.Errorf("expected %v, got %v", expected, result)
+	if first.Expense != 11000 {
+		t.Errorf("expected WMA weekly expense 11000, got %d", first.Expense)
 	}
 }
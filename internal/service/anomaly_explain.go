@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+const defaultAnomalyTopTransactions = 5
+
+// wmaWeights returns normalized WMA weights for n periods, newest first.
+func wmaWeights(n int) []float64 {
+	weights := make([]float64, n)
+	totalWeight := 0.0
+	for i := 0; i < n; i++ {
+		weights[i] = float64(n - i)
+		totalWeight += weights[i]
+	}
+
+	for i := range weights {
+		weights[i] /= totalWeight
+	}
+
+	return weights
+}
+
+// anomalyBaseline pairs a category's amounts over periods with their
+// weights.
+func anomalyBaseline(periodData map[time.Time]map[string]int64, periods []time.Time, weights []float64, categoryID string) []models.BaselinePeriod {
+	baseline := make([]models.BaselinePeriod, len(periods))
+	for i, p := range periods {
+		baseline[i] = models.BaselinePeriod{
+			PeriodStart: p,
+			Amount:      periodData[p][categoryID],
+			Weight:      weights[i],
+		}
+	}
+	return baseline
+}
+
+// attachTopTransactions fills TopTransactions of each anomaly with the
+// category's largest transactions in the analyzed period.
+func (s *AnalyzerService) attachTopTransactions(ctx context.Context, userID string, period period.Period, analyzedPeriod time.Time, anomalies []models.CategoryAnomaly) error {
+	if len(anomalies) == 0 {
+		return nil
+	}
+
+	limit := s.cfg.Anomaly.TopTransactions
+	if limit <= 0 {
+		limit = defaultAnomalyTopTransactions
+	}
+
+	transactions, err := s.storage.GetTransactions(ctx, storage.GetTransactionsRequest{
+		UserID:    userID,
+		StartDate: analyzedPeriod,
+		EndDate:   period.Next(analyzedPeriod),
+	})
+	if err != nil {
+		s.logger.Error("failed to get transactions", "error", err, "user_id", userID)
+		return fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	type categoryKey struct {
+		flowType models.TransactionType
+		mcc      string
+	}
+	byCategory := make(map[categoryKey][]models.Transaction)
+	for _, tx := range transactions {
+		key := categoryKey{flowType: tx.Type, mcc: transactionMCC(tx)}
+		byCategory[key] = append(byCategory[key], tx)
+	}
+
+	for i := range anomalies {
+		top := byCategory[categoryKey{flowType: anomalies[i].FlowType, mcc: anomalies[i].MCC}]
+		sort.SliceStable(top, func(a, b int) bool {
+			return top[a].Amount > top[b].Amount
+		})
+		if len(top) > limit {
+			top = top[:limit]
+		}
+		anomalies[i].TopTransactions = top
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestWMAWeights(t *testing.T) {
+	weights := wmaWeights(3)
+
+	expected := []float64{3.0 / 6, 2.0 / 6, 1.0 / 6}
+	for i, w := range expected {
+		if math.Abs(weights[i]-w) > 1e-9 {
+			t.Errorf("weight %d: expected %v, got %v", i, w, weights[i])
+		}
+	}
+}
+
+func explainedAnomalyStorage() *storage.MockStorage {
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Type != models.TransactionTypeExpense {
+			return nil, nil
+		}
+		return []models.CategoryPeriodStats{
+			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5812", Amount: 90000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5812", Amount: 30000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5812", Amount: 20000},
+		}, nil
+	}
+	return mockStorage
+}
+
+func TestGetAnomalies_Explanation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := explainedAnomalyStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		if !req.StartDate.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) || !req.EndDate.Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected transactions of June, got %v - %v", req.StartDate, req.EndDate)
+		}
+		return []models.Transaction{
+			expenseAt("small", 5812, 5000, "Cafe", time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC)),
+			expenseAt("banquet", 5812, 60000, "Restaurant", time.Date(2024, 6, 8, 19, 0, 0, 0, time.UTC)),
+			expenseAt("dinner", 5812, 25000, "Restaurant", time.Date(2024, 6, 12, 20, 0, 0, 0, time.UTC)),
+			expenseAt("groceries", 5411, 70000, "Market", time.Date(2024, 6, 5, 10, 0, 0, 0, time.UTC)),
+		}, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.TopTransactions = 2
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(anomalies) != 1 {
+		t.Fatalf("expected one anomaly, got %+v", anomalies)
+	}
+
+	top := anomalies[0].TopTransactions
+	if len(top) != 2 || top[0].ID != "banquet" || top[1].ID != "dinner" {
+		t.Errorf("expected banquet and dinner as top transactions, got %+v", top)
+	}
+
+	baseline := anomalies[0].Baseline
+	if len(baseline) != 2 {
+		t.Fatalf("expected two baseline periods, got %+v", baseline)
+	}
+	if baseline[0].Amount != 30000 || math.Abs(baseline[0].Weight-2.0/3) > 1e-9 {
+		t.Errorf("expected May at weight 2/3, got %+v", baseline[0])
+	}
+	if baseline[1].Amount != 20000 || math.Abs(baseline[1].Weight-1.0/3) > 1e-9 {
+		t.Errorf("expected April at weight 1/3, got %+v", baseline[1])
+	}
+}
+
+func TestGetAnomalies_ExplanationStorageError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := explainedAnomalyStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		return nil, errors.New("database error")
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if _, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{}); err == nil {
+		t.Error("expected error when transactions cannot be loaded")
+	}
+}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+const (
+	defaultFeedbackThresholdStep = 0.25
+	defaultFeedbackMaxMultiplier = 3.0
+)
+
+// AcknowledgeAnomaly marks a category anomaly as seen. periodStart may be any
+// moment within the period of the given unit; a zero periodStart acknowledges
+// it for every period.
+func (s *AnalyzerService) AcknowledgeAnomaly(ctx context.Context, userID, mcc string, flowType models.TransactionType, unit models.TimePeriod, periodStart time.Time) error {
+	return s.saveAnomalyAcknowledgement(ctx, unit, models.AnomalyAcknowledgement{
+		UserID:      userID,
+		MCC:         mcc,
+		FlowType:    flowType,
+		PeriodStart: periodStart,
+		Action:      models.AnomalyActionAcknowledged,
+	})
+}
+
+// SuppressAnomaly hides a category anomaly from GetAnomalies. periodStart may
+// be any moment within the period of the given unit; a zero periodStart
+// suppresses the category permanently.
+func (s *AnalyzerService) SuppressAnomaly(ctx context.Context, userID, mcc string, flowType models.TransactionType, unit models.TimePeriod, periodStart time.Time) error {
+	return s.saveAnomalyAcknowledgement(ctx, unit, models.AnomalyAcknowledgement{
+		UserID:      userID,
+		MCC:         mcc,
+		FlowType:    flowType,
+		PeriodStart: periodStart,
+		Action:      models.AnomalyActionSuppressed,
+	})
+}
+
+// UnsuppressAnomaly lifts a suppression saved by SuppressAnomaly for the
+// same period; a zero periodStart lifts the permanent one. Acknowledgements
+// are left in place.
+func (s *AnalyzerService) UnsuppressAnomaly(ctx context.Context, userID, mcc string, flowType models.TransactionType, unit models.TimePeriod, periodStart time.Time) error {
+	ack := models.AnomalyAcknowledgement{
+		UserID:      userID,
+		MCC:         mcc,
+		FlowType:    flowType,
+		PeriodStart: periodStart,
+		Action:      models.AnomalyActionSuppressed,
+	}
+	if err := s.normalizeAnomalyAcknowledgement(ctx, unit, &ack); err != nil {
+		return err
+	}
+
+	if err := s.storage.DeleteAnomalyAcknowledgement(ctx, ack); err != nil {
+		s.logger.Error("failed to delete anomaly suppression", "error", err, "user_id", ack.UserID)
+		return fmt.Errorf("failed to delete anomaly suppression: %w", err)
+	}
+
+	s.logger.Info("anomaly suppression removed",
+		"user_id", ack.UserID,
+		"mcc", ack.MCC,
+		"type", ack.FlowType,
+		"period_start", ack.PeriodStart,
+	)
+
+	return nil
+}
+
+func (s *AnalyzerService) saveAnomalyAcknowledgement(ctx context.Context, unit models.TimePeriod, ack models.AnomalyAcknowledgement) error {
+	if err := s.normalizeAnomalyAcknowledgement(ctx, unit, &ack); err != nil {
+		return err
+	}
+
+	if err := s.storage.SaveAnomalyAcknowledgement(ctx, ack); err != nil {
+		s.logger.Error("failed to save anomaly acknowledgement", "error", err, "user_id", ack.UserID)
+		return fmt.Errorf("failed to save anomaly acknowledgement: %w", err)
+	}
+
+	s.logger.Info("anomaly acknowledgement saved",
+		"user_id", ack.UserID,
+		"mcc", ack.MCC,
+		"type", ack.FlowType,
+		"period_start", ack.PeriodStart,
+		"action", ack.Action,
+	)
+
+	return nil
+}
+
+// normalizeAnomalyAcknowledgement validates ack and fills in its defaults.
+func (s *AnalyzerService) normalizeAnomalyAcknowledgement(ctx context.Context, unit models.TimePeriod, ack *models.AnomalyAcknowledgement) error {
+	if ack.UserID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+	if ack.MCC == "" {
+		return fmt.Errorf("mcc is required")
+	}
+
+	switch ack.FlowType {
+	case "":
+		ack.FlowType = models.TransactionTypeExpense
+	case models.TransactionTypeExpense, models.TransactionTypeIncome:
+	default:
+		return fmt.Errorf("unsupported flow type: %s", ack.FlowType)
+	}
+
+	// Stored as the period start, so it matches the analyzed period exactly.
+	if !ack.PeriodStart.IsZero() {
+		if unit == "" {
+			unit = models.TimePeriodMonth
+		}
+		period, err := s.resolvePeriod(ctx, ack.UserID, unit)
+		if err != nil {
+			return err
+		}
+		ack.PeriodStart = period.Truncate(ack.PeriodStart)
+	}
+
+	return nil
+}
+
+type anomalyKey struct {
+	flowType models.TransactionType
+	mcc      string
+}
+
+// anomalyFeedback is what a user's acknowledgements mean for one analyzed
+// period: the action that applies to it and how many earlier periods of the
+// category were acknowledged or suppressed.
+type anomalyFeedback struct {
+	actions       map[anomalyKey]models.AnomalyAction
+	earlier       map[anomalyKey]int
+	step          float64
+	maxMultiplier float64
+}
+
+// anomalyFeedback matches acknowledgements by the period containing their
+// period start, so one saved for another unit or before period starts were
+// truncated still applies to the right period. Acknowledgements come oldest
+// first and the latest one that applies wins, so acknowledging a period
+// after a permanent suppression shows the category again for that period.
+func (s *AnalyzerService) anomalyFeedback(ctx context.Context, userID string, period period.Period, analyzedPeriod time.Time) (*anomalyFeedback, error) {
+	acks, err := s.storage.GetAnomalyAcknowledgements(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get anomaly acknowledgements", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get anomaly acknowledgements: %w", err)
+	}
+
+	cfg := s.cfg.Anomaly.Feedback
+	feedback := &anomalyFeedback{
+		actions:       make(map[anomalyKey]models.AnomalyAction),
+		earlier:       make(map[anomalyKey]int),
+		step:          cfg.ThresholdStep,
+		maxMultiplier: cfg.MaxMultiplier,
+	}
+	if feedback.step <= 0 {
+		feedback.step = defaultFeedbackThresholdStep
+	}
+	if feedback.maxMultiplier <= 0 {
+		feedback.maxMultiplier = defaultFeedbackMaxMultiplier
+	}
+
+	for _, ack := range acks {
+		key := anomalyKey{flowType: ack.FlowType, mcc: ack.MCC}
+		switch {
+		case ack.PeriodStart.IsZero() || period.Truncate(ack.PeriodStart).Equal(analyzedPeriod):
+			feedback.actions[key] = ack.Action
+		case ack.PeriodStart.Before(analyzedPeriod):
+			feedback.earlier[key]++
+		}
+	}
+
+	s.logger.Info("anomaly acknowledgements loaded", "user_id", userID, "count", len(acks))
+
+	return feedback, nil
+}
+
+// thresholdMultiplier raises the category's thresholds by step for every
+// earlier period the user acknowledged, up to maxMultiplier.
+func (f *anomalyFeedback) thresholdMultiplier(flowType models.TransactionType, mcc string) float64 {
+	earlier := f.earlier[anomalyKey{flowType: flowType, mcc: mcc}]
+	return min(1+f.step*float64(earlier), f.maxMultiplier)
+}
+
+// apply drops suppressed anomalies and marks acknowledged ones.
+func (f *anomalyFeedback) apply(anomalies []models.CategoryAnomaly) []models.CategoryAnomaly {
+	kept := anomalies[:0]
+	for _, anomaly := range anomalies {
+		switch f.actions[anomalyKey{flowType: anomaly.FlowType, mcc: anomaly.MCC}] {
+		case models.AnomalyActionSuppressed:
+			continue
+		case models.AnomalyActionAcknowledged:
+			anomaly.Acknowledged = true
+		}
+		kept = append(kept, anomaly)
+	}
+	return kept
+}
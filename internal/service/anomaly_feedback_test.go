@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+// groceriesSpike doubles groceries and restaurants in June 2024.
+func groceriesSpike(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+	if req.Type != models.TransactionTypeExpense {
+		return nil, nil
+	}
+	return []models.CategoryPeriodStats{
+		{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 160000},
+		{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 80000},
+		{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5812", Amount: 80000},
+		{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5812", Amount: 40000},
+	}, nil
+}
+
+func TestSuppressAnomaly_Validation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var saved models.AnomalyAcknowledgement
+	mockStorage := storage.NewMockStorage()
+	mockStorage.SaveAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		saved = ack
+		return nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if err := service.SuppressAnomaly(context.Background(), "", "5411", "", "", time.Time{}); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	if err := service.SuppressAnomaly(context.Background(), "user-123", "", "", "", time.Time{}); err == nil {
+		t.Error("expected error for empty mcc")
+	}
+	if err := service.SuppressAnomaly(context.Background(), "user-123", "5411", models.TransactionTypeTransfer, "", time.Time{}); err == nil {
+		t.Error("expected error for transfers")
+	}
+
+	if err := service.SuppressAnomaly(context.Background(), "user-123", "5411", "", "", time.Time{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved.FlowType != models.TransactionTypeExpense || saved.Action != models.AnomalyActionSuppressed {
+		t.Errorf("expected suppressed expense, got %+v", saved)
+	}
+}
+
+func TestAcknowledgeAnomaly_TruncatesPeriodStart(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var saved models.AnomalyAcknowledgement
+	mockStorage := storage.NewMockStorage()
+	mockStorage.SaveAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		saved = ack
+		return nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	midJune := time.Date(2024, 6, 17, 15, 30, 0, 0, time.UTC)
+	if err := service.AcknowledgeAnomaly(context.Background(), "user-123", "5411", "", "", midJune); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !saved.PeriodStart.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected acknowledgement for June, got %v", saved.PeriodStart)
+	}
+
+	if err := service.AcknowledgeAnomaly(context.Background(), "user-123", "5411", "", models.TimePeriodWeek, midJune); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !saved.PeriodStart.Equal(time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected acknowledgement for the week of June 17, got %v", saved.PeriodStart)
+	}
+}
+
+func TestAcknowledgeAnomaly_StorageError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.SaveAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		return errors.New("database error")
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if err := service.AcknowledgeAnomaly(context.Background(), "user-123", "5411", models.TransactionTypeExpense, "", time.Time{}); err == nil {
+		t.Error("expected storage error to be returned")
+	}
+}
+
+func TestGetAnomalies_Acknowledgements(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = groceriesSpike
+	mockStorage.GetAnomalyAcknowledgementsFunc = func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+		return []models.AnomalyAcknowledgement{
+			{MCC: "5411", FlowType: models.TransactionTypeExpense, PeriodStart: june, Action: models.AnomalyActionAcknowledged},
+			{MCC: "5812", FlowType: models.TransactionTypeExpense, Action: models.AnomalyActionSuppressed},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 {
+		t.Fatalf("expected restaurants to be suppressed, got %+v", anomalies)
+	}
+	if anomalies[0].MCC != "5411" || !anomalies[0].Acknowledged {
+		t.Errorf("expected acknowledged groceries, got %+v", anomalies[0])
+	}
+	if !anomalies[0].PeriodStart.Equal(june) {
+		t.Errorf("expected anomaly for June, got %v", anomalies[0].PeriodStart)
+	}
+}
+
+func TestUnsuppressAnomaly(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var deleted []models.AnomalyAcknowledgement
+	mockStorage := storage.NewMockStorage()
+	mockStorage.DeleteAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		deleted = append(deleted, ack)
+		return nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if err := service.UnsuppressAnomaly(context.Background(), "user-123", "", "", "", time.Time{}); err == nil {
+		t.Error("expected error for empty mcc")
+	}
+
+	if err := service.UnsuppressAnomaly(context.Background(), "user-123", "5812", "", "", time.Time{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	midJune := time.Date(2024, 6, 17, 15, 30, 0, 0, time.UTC)
+	if err := service.UnsuppressAnomaly(context.Background(), "user-123", "5411", "", "", midJune); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(deleted) != 2 {
+		t.Fatalf("expected two suppressions lifted, got %+v", deleted)
+	}
+	if !deleted[0].PeriodStart.IsZero() || deleted[0].FlowType != models.TransactionTypeExpense || deleted[0].Action != models.AnomalyActionSuppressed {
+		t.Errorf("expected the permanent expense suppression lifted, got %+v", deleted[0])
+	}
+	if !deleted[1].PeriodStart.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the June suppression lifted, got %v", deleted[1].PeriodStart)
+	}
+}
+
+func TestUnsuppressAnomaly_StorageError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.DeleteAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		return errors.New("database error")
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if err := service.UnsuppressAnomaly(context.Background(), "user-123", "5812", "", "", time.Time{}); err == nil {
+		t.Error("expected storage error to be returned")
+	}
+}
+
+func TestGetAnomalies_UnsuppressShowsCategoryAgain(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	acks := []models.AnomalyAcknowledgement{
+		{MCC: "5812", FlowType: models.TransactionTypeExpense, Action: models.AnomalyActionSuppressed},
+	}
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = groceriesSpike
+	mockStorage.GetAnomalyAcknowledgementsFunc = func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+		return acks, nil
+	}
+	mockStorage.DeleteAnomalyAcknowledgementFunc = func(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+		kept := acks[:0]
+		for _, stored := range acks {
+			if stored.MCC != ack.MCC || stored.FlowType != ack.FlowType || !stored.PeriodStart.Equal(ack.PeriodStart) || stored.Action != ack.Action {
+				kept = append(kept, stored)
+			}
+		}
+		acks = kept
+		return nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if err := service.UnsuppressAnomaly(context.Background(), "user-123", "5812", models.TransactionTypeExpense, "", time.Time{}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 2 {
+		t.Errorf("expected restaurants reported again after unsuppress, got %+v", anomalies)
+	}
+}
+
+func TestGetAnomalies_LaterAcknowledgementOverridesSuppression(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = groceriesSpike
+	mockStorage.GetAnomalyAcknowledgementsFunc = func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+		return []models.AnomalyAcknowledgement{
+			{MCC: "5812", FlowType: models.TransactionTypeExpense, Action: models.AnomalyActionSuppressed},
+			{MCC: "5812", FlowType: models.TransactionTypeExpense, PeriodStart: june, Action: models.AnomalyActionAcknowledged},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	var restaurants *models.CategoryAnomaly
+	for i := range anomalies {
+		if anomalies[i].MCC == "5812" {
+			restaurants = &anomalies[i]
+		}
+	}
+	if restaurants == nil || !restaurants.Acknowledged {
+		t.Errorf("expected the June acknowledgement to show restaurants despite the older suppression, got %+v", anomalies)
+	}
+}
+
+func TestGetAnomalies_AcknowledgementWithinPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = groceriesSpike
+	mockStorage.GetAnomalyAcknowledgementsFunc = func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+		return []models.AnomalyAcknowledgement{
+			{MCC: "5411", FlowType: models.TransactionTypeExpense, PeriodStart: time.Date(2024, 6, 17, 15, 30, 0, 0, time.UTC), Action: models.AnomalyActionSuppressed},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, anomaly := range anomalies {
+		if anomaly.MCC == "5411" {
+			t.Errorf("expected a mid-June suppression to hide June groceries, got %+v", anomaly)
+		}
+	}
+}
+
+func TestGetAnomalies_AcknowledgementsRaiseThreshold(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = groceriesSpike
+	mockStorage.GetAnomalyAcknowledgementsFunc = func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+		var acks []models.AnomalyAcknowledgement
+		for month := time.January; month <= time.April; month++ {
+			acks = append(acks, models.AnomalyAcknowledgement{
+				MCC:         "5812",
+				FlowType:    models.TransactionTypeExpense,
+				PeriodStart: time.Date(2024, month, 1, 0, 0, 0, 0, time.UTC),
+				Action:      models.AnomalyActionAcknowledged,
+			})
+		}
+		return acks, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 || anomalies[0].MCC != "5411" {
+		t.Errorf("expected restaurants to clear the raised threshold, got %+v", anomalies)
+	}
+}
+
+func TestAnomalyFeedback_ThresholdMultiplierCap(t *testing.T) {
+	feedback := &anomalyFeedback{
+		earlier:       map[anomalyKey]int{{flowType: models.TransactionTypeExpense, mcc: "5812"}: 20},
+		step:          0.25,
+		maxMultiplier: 3,
+	}
+
+	if got := feedback.thresholdMultiplier(models.TransactionTypeExpense, "5812"); got != 3 {
+		t.Errorf("expected multiplier capped at 3, got %v", got)
+	}
+	if got := feedback.thresholdMultiplier(models.TransactionTypeIncome, "5812"); got != 1 {
+		t.Errorf("expected no change for income, got %v", got)
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+const (
+	AnomalyScoringMAD    = "mad"
+	AnomalyScoringZScore = "zscore"
+
+	// madScale makes the MAD comparable to a standard deviation for normally
+	// distributed amounts.
+	madScale = 1.4826
+
+	defaultMinSpreadPercent = 10.0
+)
+
+var defaultSeverityThresholds = config.SeverityThresholds{Low: 2, Medium: 3, High: 5}
+
+func validateAnomalyScoring(cfg config.AnomalyScoringConfig) error {
+	switch cfg.Method {
+	case "", AnomalyScoringMAD, AnomalyScoringZScore:
+		return nil
+	default:
+		return fmt.Errorf("unknown anomaly scoring method: %s", cfg.Method)
+	}
+}
+
+// anomalyScore measures actual - expected in units of the category's spread
+// over history.
+func anomalyScore(cfg config.AnomalyScoringConfig, actual, expected int64, history []float64) float64 {
+	var spread float64
+	switch cfg.Method {
+	case AnomalyScoringZScore:
+		spread = standardDeviation(history)
+	default:
+		spread = madScale * medianAbsoluteDeviation(history)
+	}
+
+	minSpreadPercent := cfg.MinSpreadPercent
+	if minSpreadPercent <= 0 {
+		minSpreadPercent = defaultMinSpreadPercent
+	}
+	spread = max(spread, float64(expected)*minSpreadPercent/100)
+
+	if spread <= 0 {
+		return 0
+	}
+	return float64(actual-expected) / spread
+}
+
+// anomalySeverity maps a score to a severity; ok is false below the low
+// threshold.
+func anomalySeverity(thresholds config.SeverityThresholds, score float64) (models.AnomalySeverity, bool) {
+	if thresholds == (config.SeverityThresholds{}) {
+		thresholds = defaultSeverityThresholds
+	}
+
+	switch {
+	case score >= thresholds.High:
+		return models.AnomalySeverityHigh, true
+	case score >= thresholds.Medium:
+		return models.AnomalySeverityMedium, true
+	case score >= thresholds.Low:
+		return models.AnomalySeverityLow, true
+	default:
+		return "", false
+	}
+}
+
+// newCategoryScore puts a category without history on the anomalyScore
+// scale: spending right at the new category threshold scores the low severity
+// threshold, and the score grows in proportion to actual / threshold, so with
+// the default thresholds 1.5 times the threshold is medium and 2.5 times high.
+func newCategoryScore(thresholds config.SeverityThresholds, actual, threshold int64) float64 {
+	if thresholds == (config.SeverityThresholds{}) {
+		thresholds = defaultSeverityThresholds
+	}
+	if threshold <= 0 {
+		return thresholds.High
+	}
+	return thresholds.Low * float64(actual) / float64(threshold)
+}
+
+// categoryHistory lists a category's amounts over the given periods, with 0
+// where it had no spending.
+func categoryHistory(periodData map[time.Time]map[string]int64, periods []time.Time, categoryID string) []float64 {
+	history := make([]float64, len(periods))
+	for i, p := range periods {
+		history[i] = float64(periodData[p][categoryID])
+	}
+	return history
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestMedianAbsoluteDeviation(t *testing.T) {
+	got := medianAbsoluteDeviation([]float64{1, 1, 2, 2, 4, 6, 9})
+	if got != 1 {
+		t.Errorf("expected MAD 1, got %v", got)
+	}
+}
+
+func TestStandardDeviation(t *testing.T) {
+	got := standardDeviation([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	if math.Abs(got-2.138) > 0.001 {
+		t.Errorf("expected sample standard deviation 2.138, got %v", got)
+	}
+
+	if standardDeviation([]float64{5}) != 0 {
+		t.Error("expected 0 for a single value")
+	}
+}
+
+func TestAnomalyScore_StableCategoryUsesSpreadFloor(t *testing.T) {
+	history := []float64{5000, 5000, 5000, 5000, 5000}
+
+	score := anomalyScore(config.AnomalyScoringConfig{}, 6000, 5000, history)
+
+	if math.Abs(score-2) > 1e-9 {
+		t.Errorf("expected a 20%% jump on a flat bill to score 2, got %v", score)
+	}
+}
+
+func TestAnomalyScore_VolatileCategory(t *testing.T) {
+	history := []float64{3000, 9000, 5000, 7000, 4000}
+
+	score := anomalyScore(config.AnomalyScoringConfig{Method: AnomalyScoringMAD}, 8000, 5500, history)
+
+	if score >= 2 {
+		t.Errorf("expected restaurant noise to stay below the low threshold, got %v", score)
+	}
+}
+
+func TestAnomalyScore_ZScore(t *testing.T) {
+	history := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	score := anomalyScore(config.AnomalyScoringConfig{Method: AnomalyScoringZScore, MinSpreadPercent: 1}, 10, 5, history)
+
+	if math.Abs(score-5/2.138) > 0.01 {
+		t.Errorf("expected z-score %.2f, got %v", 5/2.138, score)
+	}
+}
+
+func TestAnomalySeverity(t *testing.T) {
+	tests := []struct {
+		score    float64
+		severity models.AnomalySeverity
+		ok       bool
+	}{
+		{1.9, "", false},
+		{2, models.AnomalySeverityLow, true},
+		{3.5, models.AnomalySeverityMedium, true},
+		{5, models.AnomalySeverityHigh, true},
+	}
+
+	for _, tt := range tests {
+		severity, ok := anomalySeverity(config.SeverityThresholds{}, tt.score)
+		if severity != tt.severity || ok != tt.ok {
+			t.Errorf("score %v: expected (%q, %v), got (%q, %v)", tt.score, tt.severity, tt.ok, severity, ok)
+		}
+	}
+
+	custom := config.SeverityThresholds{Low: 1, Medium: 1.5, High: 2}
+	if severity, _ := anomalySeverity(custom, 1.7); severity != models.AnomalySeverityMedium {
+		t.Errorf("expected custom thresholds to give medium, got %q", severity)
+	}
+}
+
+func TestNewCategoryScore(t *testing.T) {
+	tests := []struct {
+		actual   int64
+		severity models.AnomalySeverity
+	}{
+		{60000, models.AnomalySeverityLow},
+		{80000, models.AnomalySeverityMedium},
+		{150000, models.AnomalySeverityHigh},
+	}
+
+	for _, tt := range tests {
+		score := newCategoryScore(config.SeverityThresholds{}, tt.actual, 50000)
+		if severity, _ := anomalySeverity(config.SeverityThresholds{}, score); severity != tt.severity {
+			t.Errorf("%d over a 50000 threshold: expected %q, got %q (score %v)", tt.actual, tt.severity, severity, score)
+		}
+	}
+
+	if score := newCategoryScore(config.SeverityThresholds{}, 50000, 50000); score != 2 {
+		t.Errorf("expected spending at the threshold to score the low threshold 2, got %v", score)
+	}
+}
+
+func TestGetAnomalies_StableBillVersusRestaurants(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.DeviationThreshold = 15
+
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	utilities := []int64{5000, 5000, 5000, 5000, 5000}
+	restaurants := []int64{9000, 3000, 7000, 4000, 5000}
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		stats := []models.CategoryPeriodStats{
+			{PeriodStart: june, CategoryID: "4900", Amount: 6000},
+			{PeriodStart: june, CategoryID: "5812", Amount: 8000},
+		}
+		for i := range utilities {
+			month := june.AddDate(0, -i-1, 0)
+			stats = append(stats,
+				models.CategoryPeriodStats{PeriodStart: month, CategoryID: "4900", Amount: utilities[i]},
+				models.CategoryPeriodStats{PeriodStart: month, CategoryID: "5812", Amount: restaurants[i]},
+			)
+		}
+		return stats, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 || anomalies[0].MCC != "4900" {
+		t.Fatalf("expected only the utility bill to be flagged, got %+v", anomalies)
+	}
+
+	if anomalies[0].Severity != models.AnomalySeverityLow || math.Abs(anomalies[0].Score-2) > 1e-9 {
+		t.Errorf("expected low severity with score 2, got %q %v", anomalies[0].Severity, anomalies[0].Score)
+	}
+}
+
+func TestGetAnomalies_UnknownScoringMethod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.Scoring.Method = "iqr"
+
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	if _, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{}); err == nil {
+		t.Error("expected error for unknown scoring method")
+	}
+}
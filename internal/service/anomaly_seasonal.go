@@ -0,0 +1,141 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+const (
+	defaultSeasonalYears  = 2
+	defaultSeasonalWeight = 0.5
+)
+
+// periodsPerYear is the length of the yearly cycle in periods, or 0 for
+// types where the same period last year is not meaningful.
+func periodsPerYear(period period.Period) int {
+	switch period.Unit {
+	case models.TimePeriodMonth, models.TimePeriodPayCycle, "":
+		return 12
+	case models.TimePeriodQuarter:
+		return 4
+	case models.TimePeriodHalfYear:
+		return 2
+	case models.TimePeriodWeek:
+		return 52
+	default:
+		return 0
+	}
+}
+
+// anomalyLookbackPeriods extends the configured lookback so the seasonal
+// baseline reaches back the configured number of years.
+func (s *AnalyzerService) anomalyLookbackPeriods(period period.Period) int {
+	lookbackPeriods := max(s.cfg.Anomaly.LookbackPeriods, s.seasonalYears(period)*periodsPerYear(period))
+	return partialPeriodLookback(s.cfg.Anomaly.PartialPeriod, lookbackPeriods)
+}
+
+// seasonalYears is how many previous years the seasonal baseline looks at;
+// 0 when it is off or the period type has no yearly cycle.
+func (s *AnalyzerService) seasonalYears(period period.Period) int {
+	cfg := s.cfg.Anomaly.Seasonality
+	if !cfg.Enabled || periodsPerYear(period) == 0 {
+		return 0
+	}
+	if cfg.Years <= 0 {
+		return defaultSeasonalYears
+	}
+	return cfg.Years
+}
+
+// seasonalPeriods returns the same period as analyzedPeriod in previous
+// years, newest first.
+func (s *AnalyzerService) seasonalPeriods(period period.Period, analyzedPeriod time.Time) []time.Time {
+	years := s.seasonalYears(period)
+	periods := make([]time.Time, years)
+	for y := 1; y <= years; y++ {
+		periods[y-1] = period.Add(analyzedPeriod, -y*periodsPerYear(period))
+	}
+	return periods
+}
+
+// categorySeasonalPeriods keeps the seasonal periods the category has
+// history for. It returns nil unless the category goes back at least a
+// year, so a category that appeared recently is not diluted with zeros.
+func categorySeasonalPeriods(periodData map[time.Time]map[string]int64, seasonal []time.Time, categoryID string) []time.Time {
+	if len(seasonal) == 0 {
+		return nil
+	}
+
+	var firstSeen time.Time
+	for p, amounts := range periodData {
+		if _, ok := amounts[categoryID]; ok && (firstSeen.IsZero() || p.Before(firstSeen)) {
+			firstSeen = p
+		}
+	}
+	if firstSeen.IsZero() || firstSeen.After(seasonal[0]) {
+		return nil
+	}
+
+	var periods []time.Time
+	for _, p := range seasonal {
+		if !p.Before(firstSeen) {
+			periods = append(periods, p)
+		}
+	}
+	return periods
+}
+
+// evenWeights splits a total weight of 1 evenly between n periods.
+func evenWeights(n int) []float64 {
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = 1 / float64(n)
+	}
+	return weights
+}
+
+// blendSeasonalBaseline scales the seasonal weights to weight in total and
+// the recent ones to the rest. A period found in both lists keeps the sum
+// of its weights.
+func blendSeasonalBaseline(recent, seasonal []models.BaselinePeriod, weight float64) []models.BaselinePeriod {
+	blended := make([]models.BaselinePeriod, 0, len(recent)+len(seasonal))
+	index := make(map[time.Time]int)
+
+	add := func(b models.BaselinePeriod, w float64) {
+		if i, ok := index[b.PeriodStart]; ok {
+			blended[i].Weight += w
+			return
+		}
+		index[b.PeriodStart] = len(blended)
+		b.Weight = w
+		blended = append(blended, b)
+	}
+
+	for _, b := range recent {
+		add(b, b.Weight*(1-weight))
+	}
+	for _, b := range seasonal {
+		add(b, b.Weight*weight)
+	}
+
+	return blended
+}
+
+// baselineExpectation is the weighted sum of a baseline.
+func baselineExpectation(baseline []models.BaselinePeriod) int64 {
+	sum := 0.0
+	for _, b := range baseline {
+		sum += float64(b.Amount) * b.Weight
+	}
+	return int64(sum)
+}
+
+func (s *AnalyzerService) seasonalWeight() float64 {
+	weight := s.cfg.Anomaly.Seasonality.Weight
+	if weight <= 0 {
+		return defaultSeasonalWeight
+	}
+	return min(weight, 1)
+}
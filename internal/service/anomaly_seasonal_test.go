@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+// winterUtilities bills 9000 every January and 3000 in other months, from
+// January 2023 to January 2024.
+func winterUtilities() []models.CategoryPeriodStats {
+	var stats []models.CategoryPeriodStats
+	for start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !start.Before(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)); start = start.AddDate(0, -1, 0) {
+		amount := int64(3000)
+		if start.Month() == time.January {
+			amount = 9000
+		}
+		stats = append(stats, models.CategoryPeriodStats{PeriodStart: start, CategoryID: "4900", Amount: amount})
+	}
+	return stats
+}
+
+func TestAnomalyLookbackPeriods(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	if got := service.anomalyLookbackPeriods(period.New(models.TimePeriodMonth)); got != 6 {
+		t.Errorf("expected configured lookback without seasonality, got %d", got)
+	}
+
+	cfg.Anomaly.Seasonality.Enabled = true
+	if got := service.anomalyLookbackPeriods(period.New(models.TimePeriodMonth)); got != 24 {
+		t.Errorf("expected two years of months, got %d", got)
+	}
+	if got := service.anomalyLookbackPeriods(period.New(models.TimePeriodDay)); got != 6 {
+		t.Errorf("expected days to ignore seasonality, got %d", got)
+	}
+}
+
+func TestCategorySeasonalPeriods(t *testing.T) {
+	jan2023 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	jan2022 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodData := map[time.Time]map[string]int64{
+		jan2023: {"4900": 9000},
+		time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC): {"4900": 3000, "5812": 4000},
+	}
+
+	got := categorySeasonalPeriods(periodData, []time.Time{jan2023, jan2022}, "4900")
+	if len(got) != 1 || !got[0].Equal(jan2023) {
+		t.Errorf("expected only January 2023, got %v", got)
+	}
+
+	if got := categorySeasonalPeriods(periodData, []time.Time{jan2023, jan2022}, "5812"); got != nil {
+		t.Errorf("expected no seasonal baseline under a year of history, got %v", got)
+	}
+}
+
+func TestBlendSeasonalBaseline(t *testing.T) {
+	shared := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	recent := []models.BaselinePeriod{
+		{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Amount: 100, Weight: 0.6},
+		{PeriodStart: shared, Amount: 200, Weight: 0.4},
+	}
+	seasonal := []models.BaselinePeriod{{PeriodStart: shared, Amount: 200, Weight: 1}}
+
+	blended := blendSeasonalBaseline(recent, seasonal, 0.5)
+
+	if len(blended) != 2 {
+		t.Fatalf("expected the shared period to be merged, got %+v", blended)
+	}
+	if math.Abs(blended[1].Weight-0.7) > 1e-9 {
+		t.Errorf("expected merged weight 0.7, got %v", blended[1].Weight)
+	}
+	if got := baselineExpectation(blended); got != 170 {
+		t.Errorf("expected 170, got %d", got)
+	}
+}
+
+func TestGetAnomalies_SeasonalBaseline(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Type != models.TransactionTypeExpense {
+			return nil, nil
+		}
+		return winterUtilities(), nil
+	}
+
+	cfg := getDefaultTestConfig()
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(anomalies) != 1 {
+		t.Fatalf("expected January bill flagged against recent months, got %+v", anomalies)
+	}
+
+	cfg.Anomaly.Seasonality = config.AnomalySeasonalityConfig{Enabled: true, Years: 1, Weight: 0.7}
+
+	anomalies, _, err = service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(anomalies) != 0 {
+		t.Errorf("expected January bill to match last January, got %+v", anomalies)
+	}
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+const (
+	defaultAdaptiveNewCategoryIncomePercent = 10.0
+	defaultAdaptiveVolatilityMultiplier     = 2.0
+	defaultAdaptiveMaxDeviationThreshold    = 200.0
+)
+
+// SetAnomalyThresholds stores the user's own anomaly thresholds. A nil value
+// clears that override, so the threshold is derived again.
+func (s *AnalyzerService) SetAnomalyThresholds(ctx context.Context, userID string, deviationThreshold *float64, newCategoryThreshold *int64) error {
+	if userID == "" {
+		return fmt.Errorf("user_id is required")
+	}
+	if deviationThreshold != nil && *deviationThreshold <= 0 {
+		return fmt.Errorf("deviation_threshold must be positive")
+	}
+	if newCategoryThreshold != nil && *newCategoryThreshold <= 0 {
+		return fmt.Errorf("new_category_threshold must be positive")
+	}
+
+	override := models.AnomalyThresholdOverride{
+		UserID:               userID,
+		DeviationThreshold:   deviationThreshold,
+		NewCategoryThreshold: newCategoryThreshold,
+	}
+	if err := s.storage.SaveAnomalyThresholdOverride(ctx, override); err != nil {
+		s.logger.Error("failed to save anomaly thresholds", "error", err, "user_id", userID)
+		return fmt.Errorf("failed to save anomaly thresholds: %w", err)
+	}
+
+	s.logger.Info("anomaly thresholds saved",
+		"user_id", userID,
+		"deviation_override", deviationThreshold != nil,
+		"new_category_override", newCategoryThreshold != nil,
+	)
+
+	return nil
+}
+
+// anomalyThresholds resolves the thresholds for one request: the user's
+// override, else the adaptive value when enabled and the history allows it,
+// else the configured value. The history is up to lookback_periods periods
+// before analyzedPeriod.
+func (s *AnalyzerService) anomalyThresholds(ctx context.Context, userID string, period period.Period, dataByFlow map[models.TransactionType]map[time.Time]map[string]int64, periodSet map[time.Time]bool, analyzedPeriod time.Time) (*models.AnomalyThresholds, error) {
+	override, err := s.storage.GetAnomalyThresholdOverride(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get anomaly thresholds", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get anomaly thresholds: %w", err)
+	}
+
+	thresholds := &models.AnomalyThresholds{
+		DeviationThreshold:   s.cfg.Anomaly.DeviationThreshold,
+		DeviationSource:      models.ThresholdSourceConfig,
+		NewCategoryThreshold: s.cfg.Anomaly.NewCategoryThreshold,
+		NewCategorySource:    models.ThresholdSourceConfig,
+	}
+
+	if s.cfg.Anomaly.Adaptive.Enabled {
+		history := thresholdHistory(period, periodSet, analyzedPeriod, s.cfg.Anomaly.LookbackPeriods)
+		income := flowTotals(dataByFlow[models.TransactionTypeIncome], history)
+		expenses := flowTotals(dataByFlow[models.TransactionTypeExpense], history)
+
+		if threshold, ok := s.adaptiveNewCategoryThreshold(income); ok {
+			thresholds.NewCategoryThreshold = threshold
+			thresholds.NewCategorySource = models.ThresholdSourceAdaptive
+		}
+		if threshold, ok := s.adaptiveDeviationThreshold(expenses); ok {
+			thresholds.DeviationThreshold = threshold
+			thresholds.DeviationSource = models.ThresholdSourceAdaptive
+		}
+	}
+
+	if override != nil {
+		if override.DeviationThreshold != nil {
+			thresholds.DeviationThreshold = *override.DeviationThreshold
+			thresholds.DeviationSource = models.ThresholdSourceUser
+		}
+		if override.NewCategoryThreshold != nil {
+			thresholds.NewCategoryThreshold = *override.NewCategoryThreshold
+			thresholds.NewCategorySource = models.ThresholdSourceUser
+		}
+	}
+
+	s.logger.Info("anomaly thresholds resolved",
+		"user_id", userID,
+		"deviation_threshold", thresholds.DeviationThreshold,
+		"deviation_source", thresholds.DeviationSource,
+		"new_category_threshold", thresholds.NewCategoryThreshold,
+		"new_category_source", thresholds.NewCategorySource,
+	)
+
+	return thresholds, nil
+}
+
+// adaptiveNewCategoryThreshold is a share of the average income per period;
+// it is not derived for users without income.
+func (s *AnalyzerService) adaptiveNewCategoryThreshold(income []float64) (int64, bool) {
+	average := mean(income)
+	if average <= 0 {
+		return 0, false
+	}
+
+	percent := s.cfg.Anomaly.Adaptive.NewCategoryIncomePercent
+	if percent <= 0 {
+		percent = defaultAdaptiveNewCategoryIncomePercent
+	}
+	return int64(average * percent / 100), true
+}
+
+// adaptiveDeviationThreshold widens the deviation threshold for users whose
+// total spending swings a lot from period to period. It only ever raises the
+// configured threshold; a lower one has to come from the user's override.
+func (s *AnalyzerService) adaptiveDeviationThreshold(expenses []float64) (float64, bool) {
+	average := mean(expenses)
+	if len(expenses) < 2 || average <= 0 {
+		return 0, false
+	}
+
+	cfg := s.cfg.Anomaly.Adaptive
+	multiplier := cfg.VolatilityMultiplier
+	if multiplier <= 0 {
+		multiplier = defaultAdaptiveVolatilityMultiplier
+	}
+	maxThreshold := cfg.MaxDeviationThreshold
+	if maxThreshold <= 0 {
+		maxThreshold = defaultAdaptiveMaxDeviationThreshold
+	}
+
+	volatility := standardDeviation(expenses) / average
+	threshold := multiplier * volatility * 100
+	return min(max(threshold, s.cfg.Anomaly.DeviationThreshold), maxThreshold), true
+}
+
+// thresholdHistory returns up to limit periods before analyzedPeriod,
+// newest first. Like the baseline, periods are counted back from
+// analyzedPeriod down to the first one with data, so a period without any
+// income or spending is a zero rather than being skipped.
+func thresholdHistory(period period.Period, periodSet map[time.Time]bool, analyzedPeriod time.Time, limit int) []time.Time {
+	var earliest time.Time
+	for p := range periodSet {
+		if p.Before(analyzedPeriod) && (earliest.IsZero() || p.Before(earliest)) {
+			earliest = p
+		}
+	}
+	if earliest.IsZero() {
+		return nil
+	}
+
+	var history []time.Time
+	for p := period.Add(analyzedPeriod, -1); !p.Before(earliest); p = period.Add(p, -1) {
+		if limit > 0 && len(history) == limit {
+			break
+		}
+		history = append(history, p)
+	}
+
+	return history
+}
+
+// flowTotals sums every category of a flow per period; periods without data
+// count as zero.
+func flowTotals(periodData map[time.Time]map[string]int64, periods []time.Time) []float64 {
+	totals := make([]float64, len(periods))
+	for i, p := range periods {
+		for _, amount := range periodData[p] {
+			totals[i] += float64(amount)
+		}
+	}
+	return totals
+}
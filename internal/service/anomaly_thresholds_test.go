@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+// studentHistory earns 100000 a month and spends 100000, 50000 and 150000
+// from March to May, then opens a new 30000 category in June.
+func studentHistory() *storage.MockStorage {
+	months := []time.Time{
+		time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Type == models.TransactionTypeIncome {
+			var stats []models.CategoryPeriodStats
+			for _, month := range months {
+				stats = append(stats, models.CategoryPeriodStats{PeriodStart: month, CategoryID: "uncategorized", Amount: 100000})
+			}
+			return stats, nil
+		}
+		return []models.CategoryPeriodStats{
+			{PeriodStart: months[0], CategoryID: "5411", Amount: 100000},
+			{PeriodStart: months[0], CategoryID: "7832", Amount: 30000},
+			{PeriodStart: months[1], CategoryID: "5411", Amount: 100000},
+			{PeriodStart: months[2], CategoryID: "5411", Amount: 50000},
+			{PeriodStart: months[3], CategoryID: "5411", Amount: 150000},
+		}, nil
+	}
+	return mockStorage
+}
+
+func TestGetAnomalies_ConfiguredThresholds(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(studentHistory(), logger, getDefaultTestConfig())
+
+	anomalies, thresholds, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if thresholds.DeviationSource != models.ThresholdSourceConfig || thresholds.NewCategorySource != models.ThresholdSourceConfig {
+		t.Errorf("expected configured thresholds, got %+v", thresholds)
+	}
+	if len(anomalies) != 0 {
+		t.Errorf("expected 30000 to stay below the global new category threshold, got %+v", anomalies)
+	}
+}
+
+func TestGetAnomalies_AdaptiveThresholds(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.Adaptive.Enabled = true
+	service := NewAnalyzerService(studentHistory(), logger, cfg)
+
+	anomalies, thresholds, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if thresholds.NewCategoryThreshold != 10000 || thresholds.NewCategorySource != models.ThresholdSourceAdaptive {
+		t.Errorf("expected 10%% of income as new category threshold, got %+v", thresholds)
+	}
+	if thresholds.DeviationThreshold != 100 || thresholds.DeviationSource != models.ThresholdSourceAdaptive {
+		t.Errorf("expected twice the spending volatility as deviation threshold, got %+v", thresholds)
+	}
+	if len(anomalies) != 1 || anomalies[0].MCC != "7832" {
+		t.Errorf("expected the new category flagged for a student, got %+v", anomalies)
+	}
+}
+
+func TestGetAnomalies_UserThresholds(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	deviation := 80.0
+	mockStorage := studentHistory()
+	mockStorage.GetAnomalyThresholdOverrideFunc = func(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error) {
+		return &models.AnomalyThresholdOverride{UserID: userID, DeviationThreshold: &deviation}, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.Adaptive.Enabled = true
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	_, thresholds, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if thresholds.DeviationThreshold != 80 || thresholds.DeviationSource != models.ThresholdSourceUser {
+		t.Errorf("expected the user's deviation threshold, got %+v", thresholds)
+	}
+	if thresholds.NewCategorySource != models.ThresholdSourceAdaptive {
+		t.Errorf("expected the new category threshold still adaptive, got %+v", thresholds)
+	}
+
+	mockStorage.GetAnomalyThresholdOverrideFunc = func(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error) {
+		return nil, errors.New("database error")
+	}
+	if _, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{}); err == nil {
+		t.Error("expected error when thresholds cannot be loaded")
+	}
+}
+
+func TestThresholdHistory_CountsEmptyPeriods(t *testing.T) {
+	jun := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	periodSet := map[time.Time]bool{jun: true, mar: true}
+	month := period.New(models.TimePeriodMonth)
+
+	history := thresholdHistory(month, periodSet, jun, 6)
+
+	expected := []time.Time{
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+		mar,
+	}
+	if len(history) != len(expected) {
+		t.Fatalf("expected April and May without data to be included, got %v", history)
+	}
+	for i := range expected {
+		if !history[i].Equal(expected[i]) {
+			t.Errorf("expected %v, got %v", expected, history)
+			break
+		}
+	}
+
+	income := flowTotals(map[time.Time]map[string]int64{mar: {"uncategorized": 90000}}, history)
+	if mean(income) != 30000 {
+		t.Errorf("expected the empty months to lower the average income, got %v", income)
+	}
+
+	if limited := thresholdHistory(month, periodSet, jun, 2); len(limited) != 2 {
+		t.Errorf("expected the history capped at the lookback, got %v", limited)
+	}
+}
+
+func TestAdaptiveDeviationThreshold_Bounds(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.Adaptive.MaxDeviationThreshold = 150
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	if got, _ := service.adaptiveDeviationThreshold([]float64{100000, 100000, 101000}); got != 50 {
+		t.Errorf("expected steady spending to keep the configured threshold, got %v", got)
+	}
+	if got, _ := service.adaptiveDeviationThreshold([]float64{10000, 200000, 0}); got != 150 {
+		t.Errorf("expected the threshold capped at 150, got %v", got)
+	}
+	if _, ok := service.adaptiveDeviationThreshold([]float64{100000}); ok {
+		t.Error("expected no adaptive threshold from a single period")
+	}
+}
+
+func TestSetAnomalyThresholds(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	var saved models.AnomalyThresholdOverride
+	mockStorage := storage.NewMockStorage()
+	mockStorage.SaveAnomalyThresholdOverrideFunc = func(ctx context.Context, override models.AnomalyThresholdOverride) error {
+		saved = override
+		return nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	newCategory := int64(20000)
+	if err := service.SetAnomalyThresholds(context.Background(), "user-123", nil, &newCategory); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved.DeviationThreshold != nil || saved.NewCategoryThreshold == nil || *saved.NewCategoryThreshold != 20000 {
+		t.Errorf("expected only the new category threshold saved, got %+v", saved)
+	}
+
+	negative := -1.0
+	if err := service.SetAnomalyThresholds(context.Background(), "user-123", &negative, nil); err == nil {
+		t.Error("expected error for a negative deviation threshold")
+	}
+	if err := service.SetAnomalyThresholds(context.Background(), "", nil, nil); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func (s *AnalyzerService) EvaluateForecast(ctx context.Context, userID string, unit models.TimePeriod, horizon int, methods []string) ([]models.ForecastAccuracy, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	if horizon <= 0 {
+		horizon = 1
+	}
+
+	maxPeriodsAhead := s.cfg.Forecast.MaxPeriodsAhead
+	if horizon > maxPeriodsAhead {
+		return nil, fmt.Errorf("horizon cannot exceed %d", maxPeriodsAhead)
+	}
+
+	if unit == "" {
+		unit = models.TimePeriodMonth
+	}
+
+	if len(methods) == 0 {
+		methods = ForecastMethods()
+	}
+	for _, method := range methods {
+		if _, exists := forecasters[method]; !exists {
+			return nil, fmt.Errorf("unknown forecast method: %s", method)
+		}
+	}
+
+	period, err := s.resolvePeriod(ctx, userID, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	m := seasonLength(period)
+	lookbackPeriods := s.backtestLookbackPeriods(methods, m, horizon)
+	now := s.now()
+	currentPeriodStart := period.Truncate(now)
+	startDate := period.Add(currentPeriodStart, -lookbackPeriods)
+
+	historicalData, err := s.storage.GetTransactionsForForecast(ctx, storage.GetTransactionsForForecastRequest{
+		UserID:    userID,
+		StartDate: startDate,
+		Periods:   lookbackPeriods + 1,
+		Period:    period,
+	})
+	if err != nil {
+		s.logger.Error("failed to get historical data", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get historical data: %w", err)
+	}
+
+	// The unfinished current period is not an actual to score against.
+	historicalData = denseHistory(historicalData, startDate, currentPeriodStart, period)
+	historicalData = adjustPartialPeriod(historicalData, currentPeriodStart, 0, false, lookbackPeriods)
+
+	minTrainPeriods := max(s.cfg.Forecast.Backtest.MinTrainPeriods, 1)
+	required := minTrainPeriods + horizon
+	if len(historicalData) < required {
+		return nil, fmt.Errorf("insufficient historical data for evaluation (need at least %d periods)", required)
+	}
+
+	incomes, expenses := chronologicalSeries(historicalData)
+
+	results := make([]models.ForecastAccuracy, 0, len(methods))
+	for _, method := range methods {
+		forecaster := forecasters[method](&s.cfg.Forecast, m)
+		results = append(results, models.ForecastAccuracy{
+			Method:  method,
+			Income:  backtest(forecaster, incomes, horizon, minTrainPeriods),
+			Expense: backtest(forecaster, expenses, horizon, minTrainPeriods),
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i].Expense, results[j].Expense
+		if (a.Samples == 0) != (b.Samples == 0) {
+			return a.Samples > 0
+		}
+		return a.MAE < b.MAE
+	})
+
+	s.logger.Info("forecast evaluated",
+		"user_id", userID,
+		"period", period,
+		"horizon", horizon,
+		"historical_periods", len(historicalData),
+		"methods", len(results),
+	)
+
+	return results, nil
+}
+
+// backtestLookbackPeriods extends the configured lookback so that every
+// requested method gets at least one cut-off: Holt-Winters needs two full
+// seasons before its first forecast, which a 24-month lookback never leaves.
+func (s *AnalyzerService) backtestLookbackPeriods(methods []string, seasonLength, horizon int) int {
+	lookbackPeriods := s.cfg.Forecast.Backtest.LookbackPeriods
+	for _, method := range methods {
+		if isSeasonalMethod(method) && seasonLength == 0 {
+			continue
+		}
+		forecaster := forecasters[method](&s.cfg.Forecast, seasonLength)
+		lookbackPeriods = max(lookbackPeriods, forecaster.MinPeriods()+horizon)
+	}
+	return lookbackPeriods
+}
+
+// backtest runs a rolling-origin evaluation: for every cut-off the forecaster
+// sees only the periods before it and is scored on each of the next horizon
+// periods; the metrics average over every step of every cut-off.
+func backtest(forecaster Forecaster, series []float64, horizon, minTrainPeriods int) models.AccuracyMetrics {
+	minTrain := max(minTrainPeriods, forecaster.MinPeriods())
+
+	var absErrSum, pctErrSum, errSum float64
+	samples, pctSamples := 0, 0
+
+	for cutoff := minTrain; cutoff+horizon <= len(series); cutoff++ {
+		forecast, _ := forecaster.Forecast(series[:cutoff], horizon)
+
+		for h := 0; h < horizon; h++ {
+			actual := series[cutoff+h]
+			diff := forecast[h] - actual
+
+			errSum += diff
+			absErrSum += math.Abs(diff)
+			samples++
+
+			if actual != 0 {
+				pctErrSum += math.Abs(diff) / math.Abs(actual) * 100
+				pctSamples++
+			}
+		}
+	}
+
+	if samples == 0 {
+		return models.AccuracyMetrics{}
+	}
+
+	metrics := models.AccuracyMetrics{
+		MAE:     absErrSum / float64(samples),
+		Bias:    errSum / float64(samples),
+		Samples: samples,
+	}
+	if pctSamples > 0 {
+		metrics.MAPE = pctErrSum / float64(pctSamples)
+	}
+
+	return metrics
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func getBacktestTestConfig() *config.AnalyticsConfig {
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.Backtest = config.BacktestConfig{
+		LookbackPeriods: 24,
+		MinTrainPeriods: 3,
+	}
+	return cfg
+}
+
+func buildTrendHistory(periods int) []models.PeriodStats {
+	last := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	historical := make([]models.PeriodStats, periods)
+	for i := 0; i < periods; i++ {
+		expense := int64(100000 + (periods-1-i)*5000)
+		historical[i] = models.PeriodStats{
+			PeriodStart: last.AddDate(0, -i, 0),
+			Income:      200000,
+			Expense:     expense,
+			Balance:     200000 - expense,
+		}
+	}
+	return historical
+}
+
+func TestBacktest_PerfectForecaster(t *testing.T) {
+	series := []float64{100, 200, 300, 400, 500, 600}
+
+	metrics := backtest(&linearForecaster{}, series, 2, 3)
+
+	if metrics.Samples != 4 {
+		t.Fatalf("expected 4 samples, got %d", metrics.Samples)
+	}
+
+	if metrics.MAE > 1e-6 || metrics.MAPE > 1e-6 || math.Abs(metrics.Bias) > 1e-6 {
+		t.Errorf("expected zero errors for linear series, got %+v", metrics)
+	}
+}
+
+func TestBacktest_LaggingForecasterHasNegativeBias(t *testing.T) {
+	series := []float64{100, 200, 300, 400, 500, 600}
+
+	metrics := backtest(newWMAForecaster(6), series, 1, 3)
+
+	if metrics.Bias >= 0 {
+		t.Errorf("expected negative bias on rising series, got %.2f", metrics.Bias)
+	}
+
+	if metrics.MAE != math.Abs(metrics.Bias) {
+		t.Errorf("expected MAE %.2f to equal |bias| when all errors share a sign", metrics.MAE)
+	}
+}
+
+func TestBacktest_SkipsZeroActualsInMAPE(t *testing.T) {
+	series := []float64{100, 100, 100, 0, 100}
+
+	metrics := backtest(&smaForecaster{window: 3}, series, 1, 3)
+
+	if metrics.Samples != 2 {
+		t.Fatalf("expected 2 samples, got %d", metrics.Samples)
+	}
+
+	if math.IsInf(metrics.MAPE, 0) || math.IsNaN(metrics.MAPE) {
+		t.Errorf("expected finite MAPE, got %v", metrics.MAPE)
+	}
+}
+
+func TestEvaluateForecast_RanksMethods(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getBacktestTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Periods != 27 {
+			t.Errorf("expected the lookback extended to two seasons plus the horizon and the current period, got %d", req.Periods)
+		}
+		return buildTrendHistory(12), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC) }
+
+	results, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 2, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(results) != len(ForecastMethods()) {
+		t.Fatalf("expected results for all %d methods, got %d", len(ForecastMethods()), len(results))
+	}
+
+	if best := results[0].Method; best != ForecastMethodLinear && best != ForecastMethodHolt {
+		t.Errorf("expected a trend method to win on trending data, got %s", best)
+	}
+
+	for i := 1; i < len(results); i++ {
+		if results[i].Expense.Samples > 0 && results[i].Expense.MAE < results[i-1].Expense.MAE {
+			t.Error("results should be sorted by expense MAE in ascending order")
+		}
+	}
+
+	last := results[len(results)-1]
+	if last.Expense.Samples != 0 {
+		t.Errorf("expected methods without enough history last, got %s with %d samples", last.Method, last.Expense.Samples)
+	}
+}
+
+func TestEvaluateForecast_HoltWintersGetsSamples(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getBacktestTestConfig()
+	cfg.Forecast.Seasonal = config.SeasonalForecastConfig{MinPeriods: 24, Alpha: 0.3, Beta: 0.1, Gamma: 0.3}
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return buildTrendHistory(req.Periods), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC) }
+
+	results, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, []string{ForecastMethodHoltWinters})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if results[0].Expense.Samples == 0 {
+		t.Error("expected Holt-Winters to be scored although it needs as many periods as the configured lookback")
+	}
+}
+
+func TestEvaluateForecast_SelectedMethods(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getBacktestTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return buildTrendHistory(8), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC) }
+
+	results, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, []string{ForecastMethodWMA, ForecastMethodSMA})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+}
+
+func TestEvaluateForecast_SkipsCurrentPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		history := buildTrendHistory(6)
+		// December is still running, so its expense is far below the trend.
+		history[0].Expense = 10000
+		return history, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getBacktestTestConfig())
+	service.now = func() time.Time { return time.Date(2024, 12, 5, 0, 0, 0, 0, time.UTC) }
+
+	results, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, []string{ForecastMethodLinear})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if results[0].Expense.Samples != 2 {
+		t.Errorf("expected 2 samples from July-November, got %d", results[0].Expense.Samples)
+	}
+
+	if results[0].Expense.MAE > 1 {
+		t.Errorf("expected the partial December not to be scored, got MAE %v", results[0].Expense.MAE)
+	}
+}
+
+func TestEvaluateForecast_UnknownMethod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, getBacktestTestConfig())
+
+	_, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, []string{"auto"})
+
+	if err == nil {
+		t.Fatal("expected error for unknown method, got nil")
+	}
+}
+
+func TestEvaluateForecast_InsufficientData(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return buildTrendHistory(4), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getBacktestTestConfig())
+	service.now = func() time.Time { return time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC) }
+
+	_, err := service.EvaluateForecast(context.Background(), "user-123", models.TimePeriodMonth, 2, nil)
+
+	if err == nil {
+		t.Fatal("expected error for insufficient data, got nil")
+	}
+
+	expectedMsg := "insufficient historical data for evaluation (need at least 5 periods)"
+	if err.Error() != expectedMsg {
+		t.Errorf("expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
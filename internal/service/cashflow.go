@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+const (
+	defaultCashFlowHorizonDays    = 30
+	defaultCashFlowLookbackMonths = 3
+)
+
+// GetCashFlowProjection projects the balance day by day from the current
+// balance using detected recurring income and payments plus the average daily
+// discretionary spend. currentBalance overrides the balance derived from the
+// transaction ledger when set. threshold may be zero or negative, e.g. an
+// overdraft limit; when nil, low_balance_threshold applies.
+func (s *AnalyzerService) GetCashFlowProjection(ctx context.Context, userID string, horizonDays int, threshold *int64, currentBalance *int64) (*models.CashFlowProjection, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	if horizonDays <= 0 {
+		horizonDays = s.cfg.CashFlow.HorizonDays
+	}
+	if horizonDays <= 0 {
+		horizonDays = defaultCashFlowHorizonDays
+	}
+
+	maxHorizonDays := s.cfg.CashFlow.MaxHorizonDays
+	if maxHorizonDays > 0 && horizonDays > maxHorizonDays {
+		return nil, fmt.Errorf("horizon_days cannot exceed %d", maxHorizonDays)
+	}
+
+	if threshold == nil && s.cfg.CashFlow.LowBalanceThreshold != 0 {
+		configured := s.cfg.CashFlow.LowBalanceThreshold
+		threshold = &configured
+	}
+
+	var balance int64
+	if currentBalance != nil {
+		balance = *currentBalance
+	} else {
+		var err error
+		balance, err = s.storage.GetCurrentBalance(ctx, userID)
+		if err != nil {
+			s.logger.Error("failed to get current balance", "error", err, "user_id", userID)
+			return nil, fmt.Errorf("failed to get current balance: %w", err)
+		}
+	}
+
+	expensePatterns, err := s.storage.GetRecurringPatterns(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get recurring patterns", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get recurring patterns: %w", err)
+	}
+
+	incomePatterns, err := s.storage.GetRecurringIncome(ctx, userID)
+	if err != nil {
+		s.logger.Error("failed to get recurring income", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get recurring income: %w", err)
+	}
+
+	now := s.now()
+	expensePatterns = s.activePatterns(expensePatterns, now)
+	incomePatterns = s.activePatterns(incomePatterns, now)
+
+	dailySpend, err := s.dailyDiscretionarySpend(ctx, userID, expensePatterns, now)
+	if err != nil {
+		return nil, err
+	}
+
+	projection := projectCashFlow(now, balance, horizonDays, dailySpend, incomePatterns, expensePatterns, threshold)
+
+	s.logger.Info("cash flow projected",
+		"user_id", userID,
+		"horizon_days", horizonDays,
+		"starting_balance", balance,
+		"daily_discretionary", dailySpend,
+		"recurring_income", len(incomePatterns),
+		"recurring_expenses", len(expensePatterns),
+		"below_zero", projection.BelowZeroDate != nil,
+	)
+
+	return projection, nil
+}
+
+// dailyDiscretionarySpend averages the expense not paid by recurring patterns
+// over the last lookback_months full months and the current month so far.
+func (s *AnalyzerService) dailyDiscretionarySpend(ctx context.Context, userID string, recurring []models.RecurringPattern, now time.Time) (int64, error) {
+	lookbackMonths := s.cfg.CashFlow.LookbackMonths
+	if lookbackMonths <= 0 {
+		lookbackMonths = defaultCashFlowLookbackMonths
+	}
+
+	startDate := period.New(models.TimePeriodMonth).Truncate(now).AddDate(0, -lookbackMonths, 0)
+
+	stats, err := s.storage.GetCategoryStatsByPeriods(ctx, storage.GetCategoryStatsByPeriodsRequest{
+		UserID:    userID,
+		StartDate: startDate,
+		Periods:   lookbackMonths + 1,
+		Period:    period.New(models.TimePeriodMonth),
+	})
+	if err != nil {
+		s.logger.Error("failed to get category stats", "error", err, "user_id", userID)
+		return 0, fmt.Errorf("failed to get category stats: %w", err)
+	}
+
+	total := int64(0)
+	for _, stat := range stats {
+		total += stat.Amount
+	}
+	for _, amount := range recurringSpend(recurring, stats, period.New(models.TimePeriodMonth)) {
+		total -= amount
+	}
+
+	days := now.Sub(startDate).Hours() / 24
+	if days <= 0 {
+		return 0, nil
+	}
+
+	return int64(float64(total) / days), nil
+}
+
+// projectCashFlow walks the days after now. Payments that are already due but
+// not yet seen are booked on the first projected day. A nil threshold is not
+// checked.
+func projectCashFlow(now time.Time, balance int64, horizonDays int, dailySpend int64, income, expenses []models.RecurringPattern, threshold *int64) *models.CashFlowProjection {
+	year, month, day := now.Date()
+	today := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+
+	projection := &models.CashFlowProjection{
+		StartingBalance:    balance,
+		DailyDiscretionary: dailySpend,
+		Days:               make([]models.DailyBalance, 0, horizonDays),
+	}
+
+	for d := 1; d <= horizonDays; d++ {
+		dayStart := today.AddDate(0, 0, d)
+		dayEnd := dayStart.AddDate(0, 0, 1).Add(-time.Nanosecond)
+
+		from := dayStart
+		if d == 1 {
+			from = time.Time{}
+		}
+
+		dayIncome := scheduledAmount(income, from, dayEnd)
+		dayExpense := dailySpend + scheduledAmount(expenses, from, dayEnd)
+		balance += dayIncome - dayExpense
+
+		projection.Days = append(projection.Days, models.DailyBalance{
+			Date:    dayStart,
+			Income:  dayIncome,
+			Expense: dayExpense,
+			Balance: balance,
+		})
+
+		if balance < 0 && projection.BelowZeroDate == nil {
+			date := dayStart
+			projection.BelowZeroDate = &date
+		}
+
+		if threshold != nil && balance < *threshold && projection.BelowThresholdDate == nil {
+			date := dayStart
+			projection.BelowThresholdDate = &date
+		}
+	}
+
+	return projection
+}
+
+func scheduledAmount(patterns []models.RecurringPattern, start, end time.Time) int64 {
+	total := int64(0)
+	for _, pattern := range patterns {
+		total += pattern.MedianAmount * int64(occurrencesBetween(pattern, start, end))
+	}
+	return total
+}
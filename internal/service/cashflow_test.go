@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestProjectCashFlow_SalaryAndRent(t *testing.T) {
+	now := time.Date(2024, 6, 1, 15, 0, 0, 0, time.UTC)
+
+	income := []models.RecurringPattern{
+		{MCC: "uncategorized", MedianAmount: 100000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 11, 9, 0, 0, 0, time.UTC)},
+	}
+	expenses := []models.RecurringPattern{
+		{MCC: "6513", MedianAmount: 40000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 5, 9, 0, 0, 0, time.UTC)},
+	}
+
+	threshold := int64(5000)
+	projection := projectCashFlow(now, 30000, 14, 1000, income, expenses, &threshold)
+
+	if len(projection.Days) != 14 {
+		t.Fatalf("expected 14 days, got %d", len(projection.Days))
+	}
+
+	first := projection.Days[0]
+	if !first.Date.Equal(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected projection to start tomorrow, got %v", first.Date)
+	}
+
+	rentDay := projection.Days[2]
+	if rentDay.Expense != 41000 {
+		t.Errorf("expected rent plus daily spend on June 4, got %d", rentDay.Expense)
+	}
+
+	salaryDay := projection.Days[8]
+	if salaryDay.Income != 100000 {
+		t.Errorf("expected salary on June 10, got %d", salaryDay.Income)
+	}
+
+	if projection.BelowZeroDate == nil || !projection.BelowZeroDate.Equal(rentDay.Date) {
+		t.Errorf("expected balance below zero on %v, got %v", rentDay.Date, projection.BelowZeroDate)
+	}
+
+	if projection.BelowThresholdDate == nil || !projection.BelowThresholdDate.Equal(rentDay.Date) {
+		t.Errorf("expected balance below threshold on %v, got %v", rentDay.Date, projection.BelowThresholdDate)
+	}
+
+	last := projection.Days[len(projection.Days)-1]
+	expected := int64(30000 + 100000 - 40000 - 14*1000)
+	if last.Balance != expected {
+		t.Errorf("expected final balance %d, got %d", expected, last.Balance)
+	}
+}
+
+func TestProjectCashFlow_OverduePaymentBookedOnFirstDay(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	expenses := []models.RecurringPattern{
+		{MCC: "4814", MedianAmount: 700, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	projection := projectCashFlow(now, 10000, 3, 0, nil, expenses, nil)
+
+	if projection.Days[0].Expense != 700 {
+		t.Errorf("expected overdue payment on the first day, got %d", projection.Days[0].Expense)
+	}
+
+	if projection.BelowZeroDate != nil || projection.BelowThresholdDate != nil {
+		t.Error("expected no low balance dates")
+	}
+}
+
+func TestGetCashFlowProjection_ExplicitThreshold(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.CashFlow.LowBalanceThreshold = 20000
+
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	balance := int64(10000)
+	projection, err := service.GetCashFlowProjection(context.Background(), "user-123", 3, nil, &balance)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if projection.BelowThresholdDate == nil {
+		t.Error("expected the configured threshold to apply without one in the request")
+	}
+
+	// An overdraft limit: going below zero is fine down to -5000.
+	overdraft := int64(-5000)
+	projection, err = service.GetCashFlowProjection(context.Background(), "user-123", 3, &overdraft, &balance)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if projection.BelowThresholdDate != nil {
+		t.Errorf("expected an explicit negative threshold to replace the configured one, got %v", projection.BelowThresholdDate)
+	}
+
+	zero := int64(0)
+	balance = -100
+	projection, err = service.GetCashFlowProjection(context.Background(), "user-123", 3, &zero, &balance)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if projection.BelowThresholdDate == nil {
+		t.Error("expected an explicit zero threshold to be checked")
+	}
+}
+
+func TestGetCashFlowProjection_UsesBalanceOverride(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCurrentBalanceFunc = func(ctx context.Context, userID string) (int64, error) {
+		t.Error("ledger balance should not be loaded when an override is given")
+		return 0, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	balance := int64(25000)
+	projection, err := service.GetCashFlowProjection(context.Background(), "user-123", 0, nil, &balance)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if projection.StartingBalance != 25000 {
+		t.Errorf("expected starting balance 25000, got %d", projection.StartingBalance)
+	}
+
+	if len(projection.Days) != 30 {
+		t.Errorf("expected default horizon of 30 days, got %d", len(projection.Days))
+	}
+}
+
+func TestGetCashFlowProjection_DiscretionaryExcludesRecurring(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	now := time.Date(2024, 5, 20, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	days := now.Sub(start).Hours() / 24
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCurrentBalanceFunc = func(ctx context.Context, userID string) (int64, error) {
+		return 500000, nil
+	}
+	mockStorage.GetRecurringPatternsFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return []models.RecurringPattern{
+			{MCC: "6513", MedianAmount: 40000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)},
+			{MCC: "5411", Merchant: "Grocery Box", Cadence: models.RecurringCadenceWeekly, MedianAmount: 2000, AvgIntervalDays: 7, LastOccurrence: time.Date(2024, 5, 14, 0, 0, 0, 0, time.UTC)},
+		}, nil
+	}
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if !req.StartDate.Equal(start) || req.Periods != 4 {
+			t.Errorf("expected 4 months from %v, got %d from %v", start, req.Periods, req.StartDate)
+		}
+		return []models.CategoryPeriodStats{
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 40000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 60000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "6513", Amount: 40000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), CategoryID: "5411", Amount: 30000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	service.now = func() time.Time { return now }
+
+	projection, err := service.GetCashFlowProjection(context.Background(), "user-123", 7, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Rent and the grocery boxes (five in April, two in May) are recurring;
+	// the rest of the grocery spending is not.
+	expected := int64((60000 - 10000 + 30000 - 4000) / days)
+	if projection.DailyDiscretionary < expected-1 || projection.DailyDiscretionary > expected+1 {
+		t.Errorf("expected daily discretionary around %d, got %d", expected, projection.DailyDiscretionary)
+	}
+
+	if projection.StartingBalance != 500000 {
+		t.Errorf("expected ledger balance 500000, got %d", projection.StartingBalance)
+	}
+}
+
+func TestGetCashFlowProjection_Validation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, getDefaultTestConfig())
+
+	if _, err := service.GetCashFlowProjection(context.Background(), "", 30, nil, nil); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+
+	_, err := service.GetCashFlowProjection(context.Background(), "user-123", 120, nil, nil)
+	if err == nil {
+		t.Fatal("expected error for horizon above maximum, got nil")
+	}
+
+	expectedMsg := "horizon_days cannot exceed 90"
+	if err.Error() != expectedMsg {
+		t.Errorf("expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
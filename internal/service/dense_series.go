@@ -0,0 +1,106 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+// fillPeriodGaps inserts explicit zero periods between storage rows that are
+// more than one period apart. Works for either sort order and never drops or
+// reorders the input rows.
+func fillPeriodGaps(periods []models.PeriodStats, period period.Period) []models.PeriodStats {
+	if len(periods) < 2 {
+		return periods
+	}
+
+	filled := make([]models.PeriodStats, 0, len(periods))
+	for i, p := range periods {
+		if i > 0 {
+			filled = append(filled, gapPeriods(periods[i-1].PeriodStart, p.PeriodStart, period)...)
+		}
+		filled = append(filled, p)
+	}
+
+	return filled
+}
+
+// padPeriodRange extends a series with zero periods so that it covers every
+// period overlapping [from, to]. Works for either sort order; an empty series
+// stays empty.
+func padPeriodRange(periods []models.PeriodStats, from, to time.Time, period period.Period) []models.PeriodStats {
+	if len(periods) == 0 {
+		return periods
+	}
+
+	if len(periods) > 1 && periods[0].PeriodStart.After(periods[len(periods)-1].PeriodStart) {
+		return reversePeriods(padPeriodRange(reversePeriods(periods), from, to, period))
+	}
+
+	var leading []models.PeriodStats
+	for p := period.Add(periods[0].PeriodStart, -1); !period.End(p).Before(from); p = period.Add(p, -1) {
+		leading = append([]models.PeriodStats{zeroPeriod(p, period)}, leading...)
+	}
+
+	padded := append(leading, periods...)
+	for p := period.Next(periods[len(periods)-1].PeriodStart); !p.After(to); p = period.Next(p) {
+		padded = append(padded, zeroPeriod(p, period))
+	}
+
+	return padded
+}
+
+// denseHistory fills the gaps of a forecast history (newest period first) and
+// pads it with zero periods up to currentPeriodStart, so quiet recent periods
+// count as zeros instead of being skipped. Rows before startDate lie outside
+// the requested window and are dropped; the history is not extended before
+// its oldest remaining row, where the user may simply have had no account yet.
+func denseHistory(periods []models.PeriodStats, startDate, currentPeriodStart time.Time, period period.Period) []models.PeriodStats {
+	inWindow := make([]models.PeriodStats, 0, len(periods))
+	for _, p := range periods {
+		if !p.PeriodStart.Before(startDate) {
+			inWindow = append(inWindow, p)
+		}
+	}
+
+	periods = fillPeriodGaps(inWindow, period)
+	if len(periods) == 0 {
+		return periods
+	}
+
+	oldest := periods[len(periods)-1].PeriodStart
+	return padPeriodRange(periods, oldest, currentPeriodStart, period)
+}
+
+func reversePeriods(periods []models.PeriodStats) []models.PeriodStats {
+	reversed := make([]models.PeriodStats, len(periods))
+	for i, p := range periods {
+		reversed[len(periods)-1-i] = p
+	}
+	return reversed
+}
+
+// gapPeriods returns the zero periods strictly between a and b, ordered from
+// a towards b.
+func gapPeriods(a, b time.Time, period period.Period) []models.PeriodStats {
+	step := 1
+	if b.Before(a) {
+		step = -1
+	}
+
+	var gaps []models.PeriodStats
+	for p := period.Add(a, step); (step > 0 && p.Before(b)) || (step < 0 && p.After(b)); p = period.Add(p, step) {
+		gaps = append(gaps, zeroPeriod(p, period))
+	}
+
+	return gaps
+}
+
+func zeroPeriod(start time.Time, period period.Period) models.PeriodStats {
+	return models.PeriodStats{
+		PeriodStart: start,
+		PeriodEnd:   period.End(start),
+		Categories:  []models.CategoryStats{},
+	}
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func month(m time.Month) time.Time {
+	return time.Date(2024, m, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func TestFillPeriodGaps_Ascending(t *testing.T) {
+	periods := []models.PeriodStats{
+		{PeriodStart: month(time.February), Income: 100000},
+		{PeriodStart: month(time.May), Income: 120000},
+	}
+
+	filled := fillPeriodGaps(periods, period.New(models.TimePeriodMonth))
+
+	if len(filled) != 4 {
+		t.Fatalf("expected 4 periods, got %d", len(filled))
+	}
+
+	expected := []time.Month{time.February, time.March, time.April, time.May}
+	for i, m := range expected {
+		if filled[i].PeriodStart.Month() != m {
+			t.Errorf("period %d: expected %v, got %v", i, m, filled[i].PeriodStart.Month())
+		}
+	}
+
+	if filled[1].Income != 0 || filled[1].Categories == nil {
+		t.Errorf("expected explicit zero period, got %+v", filled[1])
+	}
+
+	if !filled[2].PeriodEnd.Equal(period.New(models.TimePeriodMonth).End(month(time.April))) {
+		t.Errorf("expected period end for April, got %v", filled[2].PeriodEnd)
+	}
+}
+
+func TestFillPeriodGaps_Descending(t *testing.T) {
+	periods := []models.PeriodStats{
+		{PeriodStart: month(time.April)},
+		{PeriodStart: month(time.February)},
+		{PeriodStart: month(time.January)},
+	}
+
+	filled := fillPeriodGaps(periods, period.New(models.TimePeriodMonth))
+
+	if len(filled) != 4 || filled[1].PeriodStart.Month() != time.March {
+		t.Errorf("expected March inserted between April and February, got %+v", filled)
+	}
+}
+
+func TestPadPeriodRange(t *testing.T) {
+	periods := []models.PeriodStats{
+		{PeriodStart: month(time.March), Income: 100000},
+	}
+
+	from := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC)
+
+	padded := padPeriodRange(periods, from, to, period.New(models.TimePeriodMonth))
+
+	if len(padded) != 4 {
+		t.Fatalf("expected January through April, got %d periods", len(padded))
+	}
+
+	if padded[0].PeriodStart.Month() != time.January || padded[3].PeriodStart.Month() != time.April {
+		t.Errorf("expected range January-April, got %v-%v", padded[0].PeriodStart.Month(), padded[3].PeriodStart.Month())
+	}
+
+	if padded[2].Income != 100000 {
+		t.Error("expected original period to be kept")
+	}
+
+	descending := padPeriodRange([]models.PeriodStats{{PeriodStart: month(time.March)}, {PeriodStart: month(time.February)}}, from, to, period.New(models.TimePeriodMonth))
+	if len(descending) != 4 || descending[0].PeriodStart.Month() != time.April || descending[3].PeriodStart.Month() != time.January {
+		t.Errorf("expected descending range April-January, got %d periods", len(descending))
+	}
+
+	if len(padPeriodRange(nil, from, to, period.New(models.TimePeriodMonth))) != 0 {
+		t.Error("expected empty series to stay empty")
+	}
+}
+
+func TestDenseHistory_StartsWithinWindow(t *testing.T) {
+	periods := []models.PeriodStats{
+		{PeriodStart: month(time.April), Income: 100000},
+		{PeriodStart: month(time.February), Income: 90000},
+		{Income: 80000},
+	}
+
+	dense := denseHistory(periods, month(time.January), month(time.June), period.New(models.TimePeriodMonth))
+
+	// June back to February: the row dated before the window is dropped
+	// instead of being zero-filled from year 1.
+	if len(dense) != 5 {
+		t.Fatalf("expected June through February, got %d periods", len(dense))
+	}
+	if dense[0].PeriodStart.Month() != time.June || dense[4].PeriodStart.Month() != time.February {
+		t.Errorf("expected range June-February, got %v-%v", dense[0].PeriodStart, dense[4].PeriodStart)
+	}
+}
+
+func TestGetStatistics_DenseAndSparse(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetStatisticsFunc = func(ctx context.Context, req storage.GetStatisticsRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: month(time.February), Income: 100000, Expense: 50000, Balance: 50000},
+			{PeriodStart: month(time.April), Income: 100000, Expense: 60000, Balance: 40000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	startDate := month(time.January)
+	endDate := time.Date(2024, 4, 30, 23, 59, 59, 0, time.UTC)
+
+	dense, totalIncome, _, err := service.GetStatistics(context.Background(), "user-123", startDate, endDate, models.TimePeriodMonth, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(dense) != 4 {
+		t.Errorf("expected 4 dense periods, got %d", len(dense))
+	}
+
+	if totalIncome != 200000 {
+		t.Errorf("expected zero periods not to change totals, got %d", totalIncome)
+	}
+
+	sparse, _, _, err := service.GetStatistics(context.Background(), "user-123", startDate, endDate, models.TimePeriodMonth, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(sparse) != 2 {
+		t.Errorf("expected 2 sparse periods, got %d", len(sparse))
+	}
+}
+
+func TestGetForecast_GapCountsAsZeroPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: month(time.April), Income: 90000},
+			{PeriodStart: month(time.February), Income: 90000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	service.now = func() time.Time { return time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// WMA over (90000, 0, 90000) with weights 1, 2, 3.
+	if got := result.Forecasts[0].Income; got != 60000 {
+		t.Errorf("expected income forecast 60000, got %d", got)
+	}
+
+	if !result.Forecasts[0].PeriodStart.Equal(month(time.May)) {
+		t.Errorf("expected forecast for May, got %v", result.Forecasts[0].PeriodStart)
+	}
+}
+
+func TestGetForecast_TrailingGapCountsAsZeroPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.PartialPeriod.Mode = PartialPeriodExclude
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: month(time.April), Income: 90000},
+			{PeriodStart: month(time.February), Income: 90000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// May had no transactions; WMA over (90000, 0, 90000, 0) with weights 1-4.
+	if got := result.Forecasts[0].Income; got != 36000 {
+		t.Errorf("expected income forecast 36000, got %d", got)
+	}
+
+	if !result.Forecasts[0].PeriodStart.Equal(month(time.June)) {
+		t.Errorf("expected forecast for the current period June, got %v", result.Forecasts[0].PeriodStart)
+	}
+}
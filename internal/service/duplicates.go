@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+const defaultDuplicateLookbackDays = 30
+
+// GetDuplicateCharges finds expenses from the last days days that repeat an
+// earlier charge: same account, MCC and merchant, nearly the same amount,
+// within the duplicate window. Newest duplicates come first.
+func (s *AnalyzerService) GetDuplicateCharges(ctx context.Context, userID string, days int) ([]models.DuplicateCharge, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	cfg := s.cfg.Duplicates
+
+	if days <= 0 {
+		days = cfg.LookbackDays
+	}
+	if days <= 0 {
+		days = defaultDuplicateLookbackDays
+	}
+	if cfg.MaxLookbackDays > 0 && days > cfg.MaxLookbackDays {
+		return nil, fmt.Errorf("days cannot exceed %d", cfg.MaxLookbackDays)
+	}
+
+	window := cfg.Window()
+	now := s.now()
+	since := now.AddDate(0, 0, -days)
+
+	// The window before since is loaded too, so a charge just inside the
+	// range is still matched against the one it repeats.
+	transactions, err := s.storage.GetTransactions(ctx, storage.GetTransactionsRequest{
+		UserID:    userID,
+		StartDate: since.Add(-window),
+		EndDate:   now,
+		Type:      models.TransactionTypeExpense,
+	})
+	if err != nil {
+		s.logger.Error("failed to get transactions", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	var duplicates []models.DuplicateCharge
+	for _, charge := range findDuplicateCharges(transactions, cfg) {
+		if !charge.Duplicate.CreatedAt.Before(since) {
+			duplicates = append(duplicates, charge)
+		}
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Duplicate.CreatedAt.After(duplicates[j].Duplicate.CreatedAt)
+	})
+
+	s.logger.Info("duplicate charges detected",
+		"user_id", userID,
+		"days", days,
+		"transactions", len(transactions),
+		"duplicates_count", len(duplicates),
+	)
+
+	return duplicates, nil
+}
+
+type chargeKey struct {
+	accountID string
+	mcc       string
+	merchant  string
+}
+
+// findDuplicateCharges walks each account/MCC/merchant series in time order
+// and compares every charge with the previous one in its series, the same
+// rule the recurring query uses to drop double charges. A charge within the
+// window of the previous one and with a close enough amount is its duplicate,
+// so a triple charge gives two chained pairs.
+func findDuplicateCharges(transactions []models.Transaction, cfg config.DuplicateConfig) []models.DuplicateCharge {
+	window := cfg.Window()
+	tolerance := cfg.Tolerance()
+
+	sorted := make([]models.Transaction, len(transactions))
+	copy(sorted, transactions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	previous := make(map[chargeKey]models.Transaction)
+	var duplicates []models.DuplicateCharge
+	for _, tx := range sorted {
+		key := chargeKey{accountID: tx.AccountID, mcc: transactionMCC(tx), merchant: merchantKey(tx.Description)}
+
+		prev, ok := previous[key]
+		if ok && tx.CreatedAt.Sub(prev.CreatedAt) <= window && amountsClose(prev.Amount, tx.Amount, tolerance) {
+			duplicates = append(duplicates, models.DuplicateCharge{Original: prev, Duplicate: tx})
+		}
+		previous[key] = tx
+	}
+
+	return duplicates
+}
+
+// amountsClose reports whether a and b differ by at most tolerancePercent
+// of the larger one.
+func amountsClose(a, b int64, tolerancePercent float64) bool {
+	diff := a - b
+	if diff < 0 {
+		diff = -diff
+	}
+	return float64(diff) <= float64(max(a, b))*tolerancePercent/100
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func chargeAt(id, accountID string, amount int64, description string, createdAt time.Time) models.Transaction {
+	tx := expenseAt(id, 5814, amount, description, createdAt)
+	tx.AccountID = accountID
+	return tx
+}
+
+func TestAmountsClose(t *testing.T) {
+	if !amountsClose(10000, 10000, 1) {
+		t.Error("expected equal amounts to be close")
+	}
+	if !amountsClose(10000, 9950, 1) {
+		t.Error("expected 0.5% difference to be close")
+	}
+	if amountsClose(10000, 9800, 1) {
+		t.Error("expected 2% difference not to be close")
+	}
+}
+
+func TestFindDuplicateCharges(t *testing.T) {
+	base := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	transactions := []models.Transaction{
+		chargeAt("first", "card", 45000, "Coffee House", base),
+		chargeAt("second", "card", 45000, "coffee  house", base.Add(2*time.Minute)),
+		chargeAt("third", "card", 45000, "Coffee House", base.Add(3*time.Hour)),
+		chargeAt("other-card", "card-2", 45000, "Coffee House", base.Add(time.Minute)),
+		chargeAt("other-amount", "card", 12000, "Coffee House", base.Add(4*time.Hour)),
+		chargeAt("next-day", "card", 45000, "Coffee House", base.Add(30*time.Hour)),
+	}
+
+	duplicates := findDuplicateCharges(transactions, config.DuplicateConfig{WindowHours: 24, AmountTolerancePercent: 1})
+
+	if len(duplicates) != 2 {
+		t.Fatalf("expected the triple charge as two pairs, got %+v", duplicates)
+	}
+	for i, pair := range [][2]string{{"first", "second"}, {"second", "third"}} {
+		if duplicates[i].Original.ID != pair[0] || duplicates[i].Duplicate.ID != pair[1] {
+			t.Errorf("pair %d: expected %s/%s, got %s/%s", i, pair[0], pair[1], duplicates[i].Original.ID, duplicates[i].Duplicate.ID)
+		}
+	}
+}
+
+func TestFindDuplicateCharges_ComparesWithPreviousCharge(t *testing.T) {
+	base := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	transactions := []models.Transaction{
+		chargeAt("first", "card", 45000, "Coffee House", base),
+		chargeAt("second", "card", 45000, "Coffee House", base.Add(20*time.Hour)),
+		chargeAt("third", "card", 45000, "Coffee House", base.Add(40*time.Hour)),
+	}
+
+	// Zero config falls back to the shared defaults, as the recurring query does.
+	duplicates := findDuplicateCharges(transactions, config.DuplicateConfig{})
+
+	if len(duplicates) != 2 {
+		t.Fatalf("expected each charge to be compared with the previous one, got %+v", duplicates)
+	}
+	if duplicates[1].Original.ID != "second" || duplicates[1].Duplicate.ID != "third" {
+		t.Errorf("expected second/third, got %s/%s", duplicates[1].Original.ID, duplicates[1].Duplicate.ID)
+	}
+}
+
+func TestGetDuplicateCharges(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		if !req.StartDate.Equal(time.Date(2024, 6, 7, 12, 0, 0, 0, time.UTC)) {
+			t.Errorf("expected range plus one window, got %v", req.StartDate)
+		}
+		return []models.Transaction{
+			chargeAt("old", "card", 9900, "Streaming", time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)),
+			chargeAt("old-again", "card", 9900, "Streaming", time.Date(2024, 6, 1, 10, 5, 0, 0, time.UTC)),
+			chargeAt("taxi", "card", 30000, "Taxi", time.Date(2024, 6, 7, 23, 0, 0, 0, time.UTC)),
+			chargeAt("taxi-again", "card", 30000, "Taxi", time.Date(2024, 6, 8, 13, 0, 0, 0, time.UTC)),
+		}, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return now }
+
+	duplicates, err := service.GetDuplicateCharges(context.Background(), "user-123", 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(duplicates) != 1 || duplicates[0].Duplicate.ID != "taxi-again" {
+		t.Errorf("expected only the taxi charged twice within the range, got %+v", duplicates)
+	}
+}
+
+func TestGetDuplicateCharges_Errors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		return nil, errors.New("database error")
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Duplicates.MaxLookbackDays = 90
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	if _, err := service.GetDuplicateCharges(context.Background(), "", 7); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	if _, err := service.GetDuplicateCharges(context.Background(), "user-123", 91); err == nil {
+		t.Error("expected error when days exceed the maximum")
+	}
+	if _, err := service.GetDuplicateCharges(context.Background(), "user-123", 7); err == nil {
+		t.Error("expected storage error to be returned")
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+func seasonLength(period period.Period) int {
+	switch period.Unit {
+	case models.TimePeriodMonth, models.TimePeriodPayCycle:
+		return 12
+	case models.TimePeriodQuarter:
+		return 4
+	case models.TimePeriodHalfYear:
+		return 2
+	case models.TimePeriodWeek:
+		return 52
+	case models.TimePeriodDay:
+		return 7
+	default:
+		return 0
+	}
+}
+
+// forecastLookbackPeriods extends the lookback of seasonal methods to
+// seasonal.lookback_periods and at least to the history the method needs:
+// a weekly season is 52 periods, so the 36 configured for months would never
+// cover the two seasons Holt-Winters needs.
+func (s *AnalyzerService) forecastLookbackPeriods(method string, period period.Period) int {
+	lookbackPeriods := s.cfg.Forecast.LookbackPeriods
+	seasonal := s.cfg.Forecast.Seasonal
+	if method == ForecastMethodAuto && !seasonal.Enabled {
+		return lookbackPeriods
+	}
+
+	m := seasonLength(period)
+	if !isSeasonalMethod(method) || m == 0 {
+		return lookbackPeriods
+	}
+	if method == ForecastMethodAuto {
+		method = ForecastMethodHoltWinters
+	}
+
+	required := forecasters[method](&s.cfg.Forecast, m).MinPeriods()
+	return max(lookbackPeriods, seasonal.LookbackPeriods, required)
+}
+
+// selectForecaster resolves the configured method into a Forecaster. "auto"
+// prefers Holt-Winters when seasonal forecasting is enabled; any method that
+// lacks enough history falls back to WMA.
+func (s *AnalyzerService) selectForecaster(method string, period period.Period, historicalPeriods int) Forecaster {
+	m := seasonLength(period)
+	fallback := forecasters[ForecastMethodWMA](&s.cfg.Forecast, m)
+
+	if method == ForecastMethodAuto {
+		if !s.cfg.Forecast.Seasonal.Enabled {
+			return fallback
+		}
+		method = ForecastMethodHoltWinters
+	}
+
+	forecaster := forecasters[method](&s.cfg.Forecast, m)
+	if historicalPeriods < forecaster.MinPeriods() {
+		return fallback
+	}
+
+	return forecaster
+}
+
+// calculateForecast projects income and expense with the given forecaster.
+// When committed is set, only the discretionary remainder of expense is
+// forecast and the scheduled recurring payments are added on top.
+func (s *AnalyzerService) calculateForecast(forecaster Forecaster, historical []models.PeriodStats, periodsAhead int, period period.Period, committed *committedExpenses) []models.Forecast {
+	incomes, expenses := chronologicalSeries(historical)
+	if committed != nil {
+		n := len(expenses)
+		for i, amount := range committed.historical {
+			expenses[n-1-i] -= float64(amount)
+		}
+	}
+
+	incomeForecast, incomeResiduals := forecaster.Forecast(incomes, periodsAhead)
+	expenseForecast, expenseResiduals := forecaster.Forecast(expenses, periodsAhead)
+
+	forecasts := s.buildForecasts(historical[0].PeriodStart, period, incomeForecast, expenseForecast, incomeResiduals, expenseResiduals)
+	if committed != nil {
+		addCommittedExpenses(forecasts, committed.ahead)
+	}
+
+	return forecasts
+}
+
+func (s *AnalyzerService) buildForecasts(lastPeriod time.Time, period period.Period, incomeForecast, expenseForecast, incomeResiduals, expenseResiduals []float64) []models.Forecast {
+	incomeSigma := rootMeanSquare(incomeResiduals)
+	expenseSigma := rootMeanSquare(expenseResiduals)
+
+	forecasts := make([]models.Forecast, len(incomeForecast))
+
+	for i := range incomeForecast {
+		periodStart := period.Add(lastPeriod, i+1)
+		periodEnd := period.End(periodStart)
+		income := int64(incomeForecast[i])
+		expense := int64(expenseForecast[i])
+
+		forecasts[i] = models.Forecast{
+			PeriodStats: models.PeriodStats{
+				PeriodStart: periodStart,
+				PeriodEnd:   periodEnd,
+				Income:      income,
+				Expense:     expense,
+				Balance:     income - expense,
+				Categories:  []models.CategoryStats{},
+			},
+			DiscretionaryExpense: expense,
+			Intervals:            s.predictionIntervals(income, expense, incomeSigma, expenseSigma, i+1),
+		}
+	}
+
+	return forecasts
+}
+
+// addCommittedExpenses shifts discretionary-only forecasts by the committed
+// amount. Scheduled payments are treated as certain, so intervals move
+// without widening.
+func addCommittedExpenses(forecasts []models.Forecast, committed []int64) {
+	for i := range forecasts {
+		f := &forecasts[i]
+		amount := committed[i]
+
+		f.CommittedExpense = amount
+		f.Expense += amount
+		f.Balance -= amount
+
+		for j := range f.Intervals {
+			f.Intervals[j].ExpenseLower += amount
+			f.Intervals[j].ExpenseUpper += amount
+			f.Intervals[j].BalanceLower -= amount
+			f.Intervals[j].BalanceUpper -= amount
+		}
+	}
+}
+
+// predictionIntervals assumes normally distributed one-step errors that
+// accumulate as a random walk, so the spread grows with sqrt(horizon).
+func (s *AnalyzerService) predictionIntervals(income, expense int64, incomeSigma, expenseSigma float64, horizon int) []models.ForecastInterval {
+	levels := s.cfg.Forecast.IntervalLevels
+	if len(levels) == 0 {
+		return nil
+	}
+
+	spread := math.Sqrt(float64(horizon))
+	balanceSigma := math.Sqrt(incomeSigma*incomeSigma + expenseSigma*expenseSigma)
+	balance := income - expense
+
+	intervals := make([]models.ForecastInterval, 0, len(levels))
+	for _, level := range levels {
+		z := normalQuantile(0.5 + level/200)
+		incomeMargin := int64(z * incomeSigma * spread)
+		expenseMargin := int64(z * expenseSigma * spread)
+		balanceMargin := int64(z * balanceSigma * spread)
+
+		intervals = append(intervals, models.ForecastInterval{
+			Level:        level,
+			IncomeLower:  max(income-incomeMargin, 0),
+			IncomeUpper:  income + incomeMargin,
+			ExpenseLower: max(expense-expenseMargin, 0),
+			ExpenseUpper: expense + expenseMargin,
+			BalanceLower: balance - balanceMargin,
+			BalanceUpper: balance + balanceMargin,
+		})
+	}
+
+	return intervals
+}
+
+// seriesTrend reports the robust per-period slope of an oldest-first series,
+// also relative to the trend level at the latest period.
+func seriesTrend(series []float64) models.ForecastTrend {
+	intercept, slope := theilSen(series)
+	trend := models.ForecastTrend{Slope: slope}
+
+	level := intercept + slope*float64(len(series)-1)
+	if level > 0 {
+		trend.SlopePercent = slope / level * 100
+	}
+
+	return trend
+}
+
+// chronologicalSeries turns storage output (newest period first) into
+// oldest-first income and expense series.
+func chronologicalSeries(historical []models.PeriodStats) ([]float64, []float64) {
+	n := len(historical)
+	incomes := make([]float64, n)
+	expenses := make([]float64, n)
+
+	for i, p := range historical {
+		incomes[n-1-i] = float64(p.Income)
+		expenses[n-1-i] = float64(p.Expense)
+	}
+
+	return incomes, expenses
+}
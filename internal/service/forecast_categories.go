@@ -0,0 +1,103 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+)
+
+// forecastCategories fills Categories of every forecast period. Each MCC is
+// forecast on its own with the same model as the totals, then the category
+// amounts are scaled so they add up exactly to the forecast expense.
+func (s *AnalyzerService) forecastCategories(historical []models.PeriodStats, stats []models.CategoryPeriodStats, forecasts []models.Forecast, forecaster Forecaster) {
+	if len(stats) == 0 || len(forecasts) == 0 {
+		return
+	}
+
+	amounts := make(map[string]map[time.Time]int64)
+	for _, stat := range stats {
+		if _, exists := amounts[stat.CategoryID]; !exists {
+			amounts[stat.CategoryID] = make(map[time.Time]int64)
+		}
+		amounts[stat.CategoryID][stat.PeriodStart] += stat.Amount
+	}
+
+	n := len(historical)
+	raw := make(map[string][]float64, len(amounts))
+
+	for categoryID, byPeriod := range amounts {
+		series := make([]float64, n)
+		for i, p := range historical {
+			series[n-1-i] = float64(byPeriod[p.PeriodStart])
+		}
+		raw[categoryID], _ = forecaster.Forecast(series, len(forecasts))
+	}
+
+	for i := range forecasts {
+		forecasts[i].Categories = reconcileCategories(raw, i, forecasts[i].Expense)
+	}
+}
+
+// reconcileCategories scales the raw category forecasts for one period to the
+// total expense, distributing rounding remainders by largest fraction.
+func reconcileCategories(raw map[string][]float64, idx int, total int64) []models.CategoryStats {
+	rawTotal := 0.0
+	for _, values := range raw {
+		rawTotal += values[idx]
+	}
+
+	if rawTotal <= 0 || total <= 0 {
+		return []models.CategoryStats{}
+	}
+
+	type share struct {
+		categoryID string
+		amount     int64
+		fraction   float64
+	}
+
+	shares := make([]share, 0, len(raw))
+	allocated := int64(0)
+	for categoryID, values := range raw {
+		if values[idx] <= 0 {
+			continue
+		}
+		exact := values[idx] / rawTotal * float64(total)
+		whole := math.Floor(exact)
+		shares = append(shares, share{categoryID: categoryID, amount: int64(whole), fraction: exact - whole})
+		allocated += int64(whole)
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].fraction != shares[j].fraction {
+			return shares[i].fraction > shares[j].fraction
+		}
+		return shares[i].categoryID < shares[j].categoryID
+	})
+	for i := 0; allocated < total && len(shares) > 0; i = (i + 1) % len(shares) {
+		shares[i].amount++
+		allocated++
+	}
+
+	sort.Slice(shares, func(i, j int) bool {
+		if shares[i].amount != shares[j].amount {
+			return shares[i].amount > shares[j].amount
+		}
+		return shares[i].categoryID < shares[j].categoryID
+	})
+
+	categories := make([]models.CategoryStats, 0, len(shares))
+	for _, sh := range shares {
+		if sh.amount == 0 {
+			continue
+		}
+		categories = append(categories, models.CategoryStats{
+			CategoryID:  sh.categoryID,
+			TotalAmount: sh.amount,
+		})
+	}
+
+	return categories
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestGetForecast_CategoryBreakdown(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	june := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: june, Income: 100000, Expense: 60000},
+			{PeriodStart: may, Income: 100000, Expense: 50000},
+			{PeriodStart: april, Income: 100000, Expense: 40000},
+		}, nil
+	}
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		return []models.CategoryPeriodStats{
+			{PeriodStart: june, CategoryID: "5411", Amount: 40000},
+			{PeriodStart: june, CategoryID: "5812", Amount: 20000},
+			{PeriodStart: may, CategoryID: "5411", Amount: 35000},
+			{PeriodStart: may, CategoryID: "5812", Amount: 15000},
+			{PeriodStart: april, CategoryID: "5411", Amount: 30000},
+			{PeriodStart: april, CategoryID: "4111", Amount: 10000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forecasts := result.Forecasts
+
+	for _, f := range forecasts {
+		if len(f.Categories) != 3 {
+			t.Fatalf("expected 3 categories, got %d", len(f.Categories))
+		}
+
+		if f.Categories[0].CategoryID != "5411" {
+			t.Errorf("expected largest category 5411 first, got %s", f.Categories[0].CategoryID)
+		}
+
+		sum := int64(0)
+		for _, c := range f.Categories {
+			sum += c.TotalAmount
+		}
+		if sum != f.Expense {
+			t.Errorf("category total %d should equal forecast expense %d", sum, f.Expense)
+		}
+	}
+}
+
+func TestGetForecast_NoCategoryHistory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 95000, Expense: 48000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forecasts := result.Forecasts
+
+	if len(forecasts[0].Categories) != 0 {
+		t.Errorf("expected no categories, got %d", len(forecasts[0].Categories))
+	}
+}
+
+func TestReconcileCategories_SumsToTotal(t *testing.T) {
+	raw := map[string][]float64{
+		"5411": {333.3},
+		"5812": {333.3},
+		"4111": {333.3},
+	}
+
+	categories := reconcileCategories(raw, 0, 1000)
+
+	sum := int64(0)
+	for _, c := range categories {
+		sum += c.TotalAmount
+	}
+
+	if sum != 1000 {
+		t.Errorf("expected reconciled total 1000, got %d", sum)
+	}
+
+	for i := 1; i < len(categories); i++ {
+		if categories[i].TotalAmount > categories[i-1].TotalAmount {
+			t.Error("categories should be sorted by amount in descending order")
+		}
+	}
+}
+
+func TestReconcileCategories_ZeroTotal(t *testing.T) {
+	raw := map[string][]float64{"5411": {1000}}
+
+	categories := reconcileCategories(raw, 0, 0)
+
+	if len(categories) != 0 {
+		t.Errorf("expected no categories for zero expense, got %d", len(categories))
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/cadence"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+// committedExpenses is the part of expense covered by detected recurring
+// payments. historical is aligned with storage output (newest period first),
+// ahead with the forecast periods.
+type committedExpenses struct {
+	historical []int64
+	ahead      []int64
+}
+
+// splitCommittedExpenses attributes the past payments of active patterns to
+// committed spending and schedules every active pattern into the future
+// periods at its median amount.
+func (s *AnalyzerService) splitCommittedExpenses(historical []models.PeriodStats, stats []models.CategoryPeriodStats, patterns []models.RecurringPattern, period period.Period, periodsAhead int, now time.Time) *committedExpenses {
+	active := s.activePatterns(patterns, now)
+	if len(active) == 0 {
+		return nil
+	}
+
+	byPeriod := recurringSpend(active, stats, period)
+
+	committed := &committedExpenses{
+		historical: make([]int64, len(historical)),
+		ahead:      make([]int64, periodsAhead),
+	}
+
+	for i, p := range historical {
+		committed.historical[i] = min(byPeriod[p.PeriodStart], p.Expense)
+	}
+
+	lastPeriod := historical[0].PeriodStart
+	for i := range committed.ahead {
+		periodStart := period.Add(lastPeriod, i+1)
+		periodEnd := period.End(periodStart)
+		committed.ahead[i] = scheduledAmount(active, periodStart, periodEnd)
+	}
+
+	return committed
+}
+
+// activePatterns drops patterns whose next payment is overdue by more than
+// date_deviation_days; those are treated as cancelled.
+func (s *AnalyzerService) activePatterns(patterns []models.RecurringPattern, now time.Time) []models.RecurringPattern {
+	tolerance := time.Duration(s.cfg.Recurring.DateDeviationDays) * 24 * time.Hour
+
+	active := make([]models.RecurringPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		if pattern.Cadence == "" && pattern.AvgIntervalDays <= 0 {
+			continue
+		}
+		if nextOccurrence(pattern).Add(tolerance).Before(now) {
+			continue
+		}
+		active = append(active, pattern)
+	}
+
+	return active
+}
+
+// recurringSpend estimates what the patterns paid in each period: the median
+// amount times the payments made in the period, capped per category by what
+// the category actually spent. The rest of the category stays discretionary,
+// so a weekly grocery box does not commit all grocery spending.
+func recurringSpend(patterns []models.RecurringPattern, stats []models.CategoryPeriodStats, period period.Period) map[time.Time]int64 {
+	byMCC := make(map[string][]models.RecurringPattern, len(patterns))
+	for _, pattern := range patterns {
+		byMCC[pattern.MCC] = append(byMCC[pattern.MCC], pattern)
+	}
+
+	byPeriod := make(map[time.Time]int64)
+	for _, stat := range stats {
+		periodEnd := period.End(stat.PeriodStart)
+
+		expected := int64(0)
+		for _, pattern := range byMCC[stat.CategoryID] {
+			expected += pattern.MedianAmount * int64(pastOccurrencesBetween(pattern, stat.PeriodStart, periodEnd))
+		}
+		byPeriod[stat.PeriodStart] += min(expected, stat.Amount)
+	}
+
+	return byPeriod
+}
+
+func nextOccurrence(pattern models.RecurringPattern) time.Time {
+	return payment(pattern, 1)
+}
+
+// payment returns the n-th payment counted from the pattern's last
+// occurrence (negative n goes back): by its cadence, or by its average
+// interval when it has none. Every payment is derived from the last
+// occurrence, so month-end dates do not drift.
+func payment(pattern models.RecurringPattern, n int) time.Time {
+	if pattern.Cadence != "" {
+		return cadence.Step(pattern.Cadence, pattern.LastOccurrence, n)
+	}
+	return pattern.LastOccurrence.Add(time.Duration(n) * intervalDuration(pattern))
+}
+
+// occurrencesBetween counts the payments of a pattern expected within
+// [start, end], stepping forward from its last occurrence.
+func occurrencesBetween(pattern models.RecurringPattern, start, end time.Time) int {
+	count := 0
+	for n := 1; !payment(pattern, n).After(end); n++ {
+		if !payment(pattern, n).Before(start) {
+			count++
+		}
+	}
+	return count
+}
+
+// pastOccurrencesBetween counts the payments of a pattern made within
+// [start, end], stepping back from its last occurrence over the payments the
+// series was detected from.
+func pastOccurrencesBetween(pattern models.RecurringPattern, start, end time.Time) int {
+	count := 0
+	for n := 0; !payment(pattern, -n).Before(start); n++ {
+		// Occurrences counts intervals, so the series has one payment more.
+		if pattern.Occurrences > 0 && n > pattern.Occurrences {
+			break
+		}
+		if !payment(pattern, -n).After(end) {
+			count++
+		}
+	}
+	return count
+}
+
+func intervalDuration(pattern models.RecurringPattern) time.Duration {
+	return time.Duration(pattern.AvgIntervalDays * float64(24*time.Hour))
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestOccurrencesBetween(t *testing.T) {
+	pattern := models.RecurringPattern{
+		MCC:             "6513",
+		MedianAmount:    50000,
+		AvgIntervalDays: 7,
+		LastOccurrence:  time.Date(2024, 5, 27, 0, 0, 0, 0, time.UTC),
+	}
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := period.New(models.TimePeriodMonth).End(start)
+
+	if got := occurrencesBetween(pattern, start, end); got != 4 {
+		t.Errorf("expected 4 weekly payments in June, got %d", got)
+	}
+}
+
+func TestOccurrencesBetween_Cadence(t *testing.T) {
+	pattern := models.RecurringPattern{
+		MCC:             "6300",
+		Cadence:         models.RecurringCadenceQuarterly,
+		MedianAmount:    120000,
+		AvgIntervalDays: 91,
+		LastOccurrence:  time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	if got := occurrencesBetween(pattern, start, end); got != 3 {
+		t.Errorf("expected June, September and December premiums, got %d", got)
+	}
+}
+
+func TestOccurrencesBetween_MonthEnd(t *testing.T) {
+	pattern := models.RecurringPattern{
+		MCC:             "6513",
+		Cadence:         models.RecurringCadenceMonthly,
+		MedianAmount:    40000,
+		AvgIntervalDays: 30,
+		Occurrences:     5,
+		LastOccurrence:  time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	month := period.New(models.TimePeriodMonth)
+	for _, start := range []time.Time{
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if got := occurrencesBetween(pattern, start, month.End(start)); got != 1 {
+			t.Errorf("expected one rent payment in %v, got %d", start.Month(), got)
+		}
+	}
+
+	pattern.LastOccurrence = time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	for _, start := range []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if got := pastOccurrencesBetween(pattern, start, month.End(start)); got != 1 {
+			t.Errorf("expected one past rent payment in %v, got %d", start.Month(), got)
+		}
+	}
+}
+
+func TestSplitCommittedExpenses(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, getDefaultTestConfig())
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	historical := []models.PeriodStats{
+		{PeriodStart: may, Expense: 90000},
+		{PeriodStart: april, Expense: 70000},
+	}
+
+	stats := []models.CategoryPeriodStats{
+		{PeriodStart: may, CategoryID: "6513", Amount: 40000},
+		{PeriodStart: may, CategoryID: "5411", Amount: 50000},
+		{PeriodStart: april, CategoryID: "6513", Amount: 40000},
+		{PeriodStart: april, CategoryID: "4900", Amount: 30000},
+	}
+
+	patterns := []models.RecurringPattern{
+		{MCC: "6513", MedianAmount: 40000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)},
+		{MCC: "4900", MedianAmount: 30000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	now := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	committed := service.splitCommittedExpenses(historical, stats, patterns, period.New(models.TimePeriodMonth), 2, now)
+
+	if committed == nil {
+		t.Fatal("expected committed expenses, got nil")
+	}
+
+	if committed.historical[0] != 40000 || committed.historical[1] != 40000 {
+		t.Errorf("expected only the active pattern's history to be committed, got %v", committed.historical)
+	}
+
+	if committed.ahead[0] != 40000 || committed.ahead[1] != 40000 {
+		t.Errorf("expected one rent payment per month, got %v", committed.ahead)
+	}
+}
+
+func TestSplitCommittedExpenses_SharedMCC(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, getDefaultTestConfig())
+
+	may := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	historical := []models.PeriodStats{
+		{PeriodStart: may, Expense: 100000},
+		{PeriodStart: april, Expense: 100000},
+		{PeriodStart: march, Expense: 100000},
+	}
+
+	var stats []models.CategoryPeriodStats
+	for _, p := range []time.Time{may, april, march} {
+		stats = append(stats,
+			models.CategoryPeriodStats{PeriodStart: p, CategoryID: "5411", Amount: 60000},
+			models.CategoryPeriodStats{PeriodStart: p, CategoryID: "5812", Amount: 40000},
+		)
+	}
+
+	// A weekly grocery box shares 5411 with the rest of the grocery spending.
+	patterns := []models.RecurringPattern{
+		{
+			MCC:             "5411",
+			Merchant:        "Grocery Box",
+			Cadence:         models.RecurringCadenceWeekly,
+			MedianAmount:    2000,
+			AvgIntervalDays: 7,
+			Occurrences:     12,
+			LastOccurrence:  time.Date(2024, 5, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	now := time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC)
+	committed := service.splitCommittedExpenses(historical, stats, patterns, period.New(models.TimePeriodMonth), 1, now)
+
+	if committed == nil {
+		t.Fatal("expected committed expenses, got nil")
+	}
+
+	expected := []int64{8000, 10000, 8000}
+	for i, amount := range expected {
+		if committed.historical[i] != amount {
+			t.Errorf("expected only the grocery box payments to be committed, got %v", committed.historical)
+			break
+		}
+	}
+
+	if committed.ahead[0] != 8000 {
+		t.Errorf("expected four boxes in June, got %d", committed.ahead[0])
+	}
+}
+
+func TestSplitCommittedExpenses_NoActivePatterns(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, getDefaultTestConfig())
+
+	historical := []models.PeriodStats{
+		{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Expense: 90000},
+	}
+
+	patterns := []models.RecurringPattern{
+		{MCC: "6513", MedianAmount: 40000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	now := time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	if committed := service.splitCommittedExpenses(historical, nil, patterns, period.New(models.TimePeriodMonth), 1, now); committed != nil {
+		t.Errorf("expected nil for lapsed patterns, got %+v", committed)
+	}
+}
+
+func TestGetForecast_HybridSplitsCommittedExpense(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.Hybrid.Enabled = true
+	cfg.Forecast.IntervalLevels = []float64{80}
+
+	current := period.New(models.TimePeriodMonth).Truncate(time.Now())
+	previous := current.AddDate(0, -1, 0)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: current, Income: 100000, Expense: 70000},
+			{PeriodStart: previous, Income: 100000, Expense: 50000},
+		}, nil
+	}
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		return []models.CategoryPeriodStats{
+			{PeriodStart: current, CategoryID: "6513", Amount: 30000},
+			{PeriodStart: current, CategoryID: "5411", Amount: 40000},
+			{PeriodStart: previous, CategoryID: "6513", Amount: 30000},
+			{PeriodStart: previous, CategoryID: "5411", Amount: 20000},
+		}, nil
+	}
+	mockStorage.GetRecurringPatternsFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return []models.RecurringPattern{
+			{MCC: "6513", MedianAmount: 30000, AvgIntervalDays: 30, LastOccurrence: time.Now().AddDate(0, 0, -1)},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 3, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	totalCommitted := int64(0)
+	for _, f := range result.Forecasts {
+		if f.CommittedExpense+f.DiscretionaryExpense != f.Expense {
+			t.Errorf("committed %d + discretionary %d should equal expense %d", f.CommittedExpense, f.DiscretionaryExpense, f.Expense)
+		}
+
+		if f.CommittedExpense%30000 != 0 {
+			t.Errorf("expected committed expense in whole rent payments, got %d", f.CommittedExpense)
+		}
+
+		if f.Intervals[0].ExpenseLower < f.CommittedExpense {
+			t.Errorf("expense lower bound %d should not drop below committed %d", f.Intervals[0].ExpenseLower, f.CommittedExpense)
+		}
+
+		totalCommitted += f.CommittedExpense
+	}
+
+	if totalCommitted == 0 {
+		t.Error("expected recurring payments to be scheduled into the forecast")
+	}
+
+	// WMA over the discretionary history (20000, 40000) is 33333.
+	if got := result.Forecasts[0].DiscretionaryExpense; got != 33333 {
+		t.Errorf("expected discretionary forecast 33333, got %d", got)
+	}
+}
+
+func TestGetForecast_HybridDisabledIsFullyDiscretionary(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 70000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+		}, nil
+	}
+	mockStorage.GetRecurringPatternsFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		t.Error("recurring patterns should not be loaded when hybrid forecast is disabled")
+		return nil, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	f := result.Forecasts[0]
+	if f.CommittedExpense != 0 || f.DiscretionaryExpense != f.Expense {
+		t.Errorf("expected fully discretionary forecast, got committed %d discretionary %d", f.CommittedExpense, f.DiscretionaryExpense)
+	}
+}
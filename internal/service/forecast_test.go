@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func getSeasonalTestConfig() *config.AnalyticsConfig {
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.Seasonal = config.SeasonalForecastConfig{
+		Enabled:         true,
+		MinPeriods:      24,
+		LookbackPeriods: 36,
+		Alpha:           0.3,
+		Beta:            0.1,
+		Gamma:           0.3,
+	}
+	return cfg
+}
+
+func seasonalExpense(month time.Month) int64 {
+	switch month {
+	case time.December:
+		return 150000
+	case time.July, time.August:
+		return 110000
+	default:
+		return 80000
+	}
+}
+
+func buildSeasonalHistory(last time.Time, periods int) []models.PeriodStats {
+	historical := make([]models.PeriodStats, periods)
+	for i := 0; i < periods; i++ {
+		start := last.AddDate(0, -i, 0)
+		expense := seasonalExpense(start.Month())
+		historical[i] = models.PeriodStats{
+			PeriodStart: start,
+			PeriodEnd:   period.New(models.TimePeriodMonth).End(start),
+			Income:      200000,
+			Expense:     expense,
+			Balance:     200000 - expense,
+		}
+	}
+	return historical
+}
+
+func TestHoltWinters_ReproducesSeasonalPattern(t *testing.T) {
+	series := make([]float64, 0, 36)
+	for i := 0; i < 36; i++ {
+		series = append(series, float64(seasonalExpense(time.Month(i%12+1))))
+	}
+
+	forecast, _ := holtWinters(series, 12, 12, 0.3, 0.1, 0.3)
+
+	if len(forecast) != 12 {
+		t.Fatalf("expected 12 forecast values, got %d", len(forecast))
+	}
+
+	for i, value := range forecast {
+		expected := float64(seasonalExpense(time.Month(i%12 + 1)))
+		if math.Abs(value-expected) > 1000 {
+			t.Errorf("month %d: expected around %.0f, got %.0f", i+1, expected, value)
+		}
+	}
+}
+
+func TestHoltWinters_NeverNegative(t *testing.T) {
+	series := make([]float64, 0, 24)
+	for i := 0; i < 24; i++ {
+		series = append(series, float64(24-i)*1000)
+	}
+
+	forecast, _ := holtWinters(series, 12, 12, 0.5, 0.5, 0.1)
+
+	for i, value := range forecast {
+		if value < 0 {
+			t.Errorf("forecast %d should not be negative, got %.0f", i, value)
+		}
+	}
+}
+
+func TestGetForecast_SeasonalWithEnoughHistory(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getSeasonalTestConfig()
+
+	last := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Periods != 36 {
+			t.Errorf("expected seasonal lookback of 36 req.Periods, got %d", req.Periods)
+		}
+		return buildSeasonalHistory(last, 30), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 3, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forecasts := result.Forecasts
+
+	if len(forecasts) != 3 {
+		t.Fatalf("expected 3 forecasts, got %d", len(forecasts))
+	}
+
+	december := forecasts[0]
+	if december.PeriodStart.Month() != time.December {
+		t.Fatalf("expected first forecast for December, got %v", december.PeriodStart.Month())
+	}
+
+	if december.Expense <= forecasts[1].Expense {
+		t.Errorf("expected December expense %d to exceed January expense %d", december.Expense, forecasts[1].Expense)
+	}
+
+	if december.Balance != december.Income-december.Expense {
+		t.Error("balance should equal income - expense")
+	}
+}
+
+func TestGetForecast_SeasonalFallsBackToWMA(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getSeasonalTestConfig()
+
+	last := time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return buildSeasonalHistory(last, 12), nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 11, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 3, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forecasts := result.Forecasts
+
+	for i := 1; i < len(forecasts); i++ {
+		if forecasts[i].Expense != forecasts[0].Expense {
+			t.Errorf("expected flat WMA forecast, period %d differs", i)
+		}
+	}
+}
+
+func TestGetForecast_SeasonalWeeklyLookback(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getSeasonalTestConfig()
+
+	lastWeek := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Periods != 104 {
+			t.Errorf("expected two 52-week seasons of lookback, got %d", req.Periods)
+		}
+		history := make([]models.PeriodStats, req.Periods)
+		for i := range history {
+			expense := int64(20000)
+			if i%52 == 0 {
+				expense = 60000
+			}
+			history[i] = models.PeriodStats{PeriodStart: lastWeek.AddDate(0, 0, -7*i), Income: 50000, Expense: expense}
+		}
+		return history, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodWeek, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Method != ForecastMethodHoltWinters {
+		t.Errorf("expected weekly history of two seasons to use holt_winters, got %s", result.Method)
+	}
+}
+
+func TestGetForecast_SeasonalNotUsedForYears(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getSeasonalTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Periods != cfg.Forecast.LookbackPeriods {
+			t.Errorf("expected default lookback for yearly forecast, got %d", req.Periods)
+		}
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Income: 1200000, Expense: 600000},
+			{PeriodStart: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), Income: 1150000, Expense: 580000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	if _, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodYear, 2, ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestGetForecast_PredictionIntervals(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.IntervalLevels = []float64{80, 95}
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Income: 110000, Expense: 70000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 90000, Expense: 40000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 60000},
+			{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Income: 95000, Expense: 45000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	forecasts := result.Forecasts
+
+	for _, f := range forecasts {
+		if len(f.Intervals) != 2 {
+			t.Fatalf("expected 2 intervals, got %d", len(f.Intervals))
+		}
+
+		narrow, wide := f.Intervals[0], f.Intervals[1]
+		if narrow.Level != 80 || wide.Level != 95 {
+			t.Errorf("expected levels 80 and 95, got %v and %v", narrow.Level, wide.Level)
+		}
+
+		if narrow.ExpenseLower > f.Expense || narrow.ExpenseUpper < f.Expense {
+			t.Errorf("expense %d outside its interval [%d, %d]", f.Expense, narrow.ExpenseLower, narrow.ExpenseUpper)
+		}
+
+		if wide.ExpenseLower > narrow.ExpenseLower || wide.ExpenseUpper < narrow.ExpenseUpper {
+			t.Error("95% interval should contain the 80% interval")
+		}
+
+		if narrow.BalanceUpper-narrow.BalanceLower <= 0 {
+			t.Error("expected non-empty balance interval for noisy history")
+		}
+	}
+
+	firstWidth := forecasts[0].Intervals[0].ExpenseUpper - forecasts[0].Intervals[0].ExpenseLower
+	secondWidth := forecasts[1].Intervals[0].ExpenseUpper - forecasts[1].Intervals[0].ExpenseLower
+	if secondWidth <= firstWidth {
+		t.Errorf("expected interval to widen with horizon, got %d then %d", firstWidth, secondWidth)
+	}
+}
+
+func TestPredictionIntervals_StableHistoryHasZeroWidth(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.IntervalLevels = []float64{95}
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	historical := []models.PeriodStats{
+		{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+		{PeriodStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+		{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+	}
+
+	forecasts := service.calculateForecast(newWMAForecaster(6), historical, 1, period.New(models.TimePeriodMonth), nil)
+	interval := forecasts[0].Intervals[0]
+
+	if interval.IncomeLower != 100000 || interval.IncomeUpper != 100000 {
+		t.Errorf("expected degenerate income interval, got [%d, %d]", interval.IncomeLower, interval.IncomeUpper)
+	}
+}
+
+func TestTheilSen_IgnoresOutlier(t *testing.T) {
+	intercept, slope := theilSen([]float64{100, 110, 120, 500, 140, 150})
+
+	if math.Abs(slope-10) > 1e-6 {
+		t.Errorf("expected slope 10, got %v", slope)
+	}
+
+	if math.Abs(intercept-100) > 1e-6 {
+		t.Errorf("expected intercept 100, got %v", intercept)
+	}
+}
+
+func TestSeriesTrend_Percent(t *testing.T) {
+	trend := seriesTrend([]float64{90000, 93000, 96000, 99000, 102000})
+
+	if math.Abs(trend.Slope-3000) > 1e-6 {
+		t.Errorf("expected slope 3000, got %v", trend.Slope)
+	}
+
+	expected := 3000.0 / 102000 * 100
+	if math.Abs(trend.SlopePercent-expected) > 1e-6 {
+		t.Errorf("expected %.4f%%, got %.4f%%", expected, trend.SlopePercent)
+	}
+}
+
+func TestGetForecast_ReportsTrend(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 130000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Income: 120000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Income: 110000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	service.now = func() time.Time { return time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodQuarter, 1, ForecastMethodHolt)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Method != ForecastMethodHolt {
+		t.Errorf("expected method holt, got %s", result.Method)
+	}
+
+	if math.Abs(result.IncomeTrend.Slope-10000) > 1e-6 {
+		t.Errorf("expected income slope 10000, got %v", result.IncomeTrend.Slope)
+	}
+
+	if result.ExpenseTrend.Slope != 0 || result.ExpenseTrend.SlopePercent != 0 {
+		t.Errorf("expected flat expense trend, got %+v", result.ExpenseTrend)
+	}
+
+	if result.Forecasts[0].Income != 140000 {
+		t.Errorf("expected Holt income forecast 140000, got %d", result.Forecasts[0].Income)
+	}
+}
+
+func TestNormalQuantile(t *testing.T) {
+	tests := []struct {
+		p        float64
+		expected float64
+	}{
+		{0.5, 0},
+		{0.9, 1.2816},
+		{0.975, 1.9600},
+		{0.995, 2.5758},
+		{0.01, -2.3263},
+	}
+
+	for _, tt := range tests {
+		result := normalQuantile(tt.p)
+		if math.Abs(result-tt.expected) > 1e-3 {
+			t.Errorf("normalQuantile(%v): expected %v, got %v", tt.p, tt.expected, result)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+)
+
+const (
+	ForecastMethodAuto          = "auto"
+	ForecastMethodWMA           = "wma"
+	ForecastMethodSMA           = "sma"
+	ForecastMethodExponential   = "exponential"
+	ForecastMethodLinear        = "linear"
+	ForecastMethodHolt          = "holt"
+	ForecastMethodSeasonalNaive = "seasonal_naive"
+	ForecastMethodHoltWinters   = "holt_winters"
+)
+
+// Forecaster projects a single oldest-first series periodsAhead steps forward.
+// Alongside the forecast it returns the in-sample one-step-ahead errors, which
+// drive the prediction intervals.
+type Forecaster interface {
+	Name() string
+	MinPeriods() int
+	Forecast(series []float64, periodsAhead int) ([]float64, []float64)
+}
+
+type forecasterFactory func(cfg *config.ForecastConfig, seasonLength int) Forecaster
+
+var forecasters = map[string]forecasterFactory{
+	ForecastMethodWMA: func(cfg *config.ForecastConfig, _ int) Forecaster {
+		return newWMAForecaster(cfg.Window)
+	},
+	ForecastMethodSMA: func(cfg *config.ForecastConfig, _ int) Forecaster {
+		return &smaForecaster{window: cfg.Window}
+	},
+	ForecastMethodExponential: func(cfg *config.ForecastConfig, _ int) Forecaster {
+		return &exponentialForecaster{alpha: cfg.Exponential.Alpha}
+	},
+	ForecastMethodLinear: func(cfg *config.ForecastConfig, _ int) Forecaster {
+		return &linearForecaster{}
+	},
+	ForecastMethodHolt: func(cfg *config.ForecastConfig, _ int) Forecaster {
+		return &holtForecaster{alpha: cfg.Holt.Alpha, beta: cfg.Holt.Beta}
+	},
+	ForecastMethodSeasonalNaive: func(cfg *config.ForecastConfig, seasonLength int) Forecaster {
+		return &seasonalNaiveForecaster{seasonLength: seasonLength}
+	},
+	ForecastMethodHoltWinters: func(cfg *config.ForecastConfig, seasonLength int) Forecaster {
+		return &holtWintersForecaster{
+			seasonLength: seasonLength,
+			minPeriods:   cfg.Seasonal.MinPeriods,
+			alpha:        cfg.Seasonal.Alpha,
+			beta:         cfg.Seasonal.Beta,
+			gamma:        cfg.Seasonal.Gamma,
+		}
+	},
+}
+
+func ForecastMethods() []string {
+	methods := make([]string, 0, len(forecasters))
+	for name := range forecasters {
+		methods = append(methods, name)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func validateForecastMethod(method string) error {
+	if method == ForecastMethodAuto {
+		return nil
+	}
+	if _, exists := forecasters[method]; !exists {
+		return fmt.Errorf("unknown forecast method: %s", method)
+	}
+	return nil
+}
+
+func isSeasonalMethod(method string) bool {
+	return method == ForecastMethodAuto || method == ForecastMethodHoltWinters || method == ForecastMethodSeasonalNaive
+}
+
+type wmaForecaster struct {
+	window int
+}
+
+func newWMAForecaster(window int) *wmaForecaster {
+	return &wmaForecaster{window: window}
+}
+
+func (f *wmaForecaster) Name() string { return ForecastMethodWMA }
+
+func (f *wmaForecaster) MinPeriods() int { return 1 }
+
+func (f *wmaForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	avg := weightedAverage(tail(series, f.window))
+	return repeat(avg, periodsAhead), oneStepResiduals(series, f.window, weightedAverage)
+}
+
+type smaForecaster struct {
+	window int
+}
+
+func (f *smaForecaster) Name() string { return ForecastMethodSMA }
+
+func (f *smaForecaster) MinPeriods() int { return 1 }
+
+func (f *smaForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	avg := mean(tail(series, f.window))
+	return repeat(avg, periodsAhead), oneStepResiduals(series, f.window, mean)
+}
+
+type exponentialForecaster struct {
+	alpha float64
+}
+
+func (f *exponentialForecaster) Name() string { return ForecastMethodExponential }
+
+func (f *exponentialForecaster) MinPeriods() int { return 1 }
+
+func (f *exponentialForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	level := series[0]
+	residuals := make([]float64, 0, len(series)-1)
+	for t := 1; t < len(series); t++ {
+		residuals = append(residuals, series[t]-level)
+		level = f.alpha*series[t] + (1-f.alpha)*level
+	}
+	return repeat(level, periodsAhead), residuals
+}
+
+type linearForecaster struct{}
+
+func (f *linearForecaster) Name() string { return ForecastMethodLinear }
+
+func (f *linearForecaster) MinPeriods() int { return 2 }
+
+func (f *linearForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	intercept, slope := linearRegression(series)
+	n := len(series)
+
+	// Residuals come from a line refitted on the periods before each step, so
+	// the intervals reflect out-of-sample errors rather than the fit itself.
+	residuals := make([]float64, 0, n-1)
+	for t := 1; t < n; t++ {
+		a, b := linearRegression(series[:t])
+		residuals = append(residuals, series[t]-(a+b*float64(t)))
+	}
+
+	forecast := make([]float64, periodsAhead)
+	for h := 1; h <= periodsAhead; h++ {
+		forecast[h-1] = math.Max(intercept+slope*float64(n-1+h), 0)
+	}
+	return forecast, residuals
+}
+
+// holtForecaster is double exponential smoothing: a smoothed level plus a
+// smoothed per-period trend, so steadily growing series are not lagged.
+type holtForecaster struct {
+	alpha float64
+	beta  float64
+}
+
+func (f *holtForecaster) Name() string { return ForecastMethodHolt }
+
+func (f *holtForecaster) MinPeriods() int { return 2 }
+
+func (f *holtForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	level := series[0]
+	trend := series[1] - series[0]
+
+	residuals := make([]float64, 0, len(series)-1)
+	for t := 1; t < len(series); t++ {
+		residuals = append(residuals, series[t]-(level+trend))
+		lastLevel := level
+		level = f.alpha*series[t] + (1-f.alpha)*(level+trend)
+		trend = f.beta*(level-lastLevel) + (1-f.beta)*trend
+	}
+
+	forecast := make([]float64, periodsAhead)
+	for h := 1; h <= periodsAhead; h++ {
+		forecast[h-1] = math.Max(level+float64(h)*trend, 0)
+	}
+	return forecast, residuals
+}
+
+type seasonalNaiveForecaster struct {
+	seasonLength int
+}
+
+func (f *seasonalNaiveForecaster) Name() string { return ForecastMethodSeasonalNaive }
+
+func (f *seasonalNaiveForecaster) MinPeriods() int {
+	if f.seasonLength == 0 {
+		return math.MaxInt32
+	}
+	return f.seasonLength
+}
+
+func (f *seasonalNaiveForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	n := len(series)
+	m := f.seasonLength
+
+	residuals := make([]float64, 0, n-m)
+	for t := m; t < n; t++ {
+		residuals = append(residuals, series[t]-series[t-m])
+	}
+
+	forecast := make([]float64, periodsAhead)
+	for h := 1; h <= periodsAhead; h++ {
+		forecast[h-1] = series[n-m+(h-1)%m]
+	}
+	return forecast, residuals
+}
+
+type holtWintersForecaster struct {
+	seasonLength int
+	minPeriods   int
+	alpha        float64
+	beta         float64
+	gamma        float64
+}
+
+func (f *holtWintersForecaster) Name() string { return ForecastMethodHoltWinters }
+
+func (f *holtWintersForecaster) MinPeriods() int {
+	if f.seasonLength == 0 {
+		return math.MaxInt32
+	}
+	return max(f.minPeriods, 2*f.seasonLength)
+}
+
+func (f *holtWintersForecaster) Forecast(series []float64, periodsAhead int) ([]float64, []float64) {
+	return holtWinters(series, f.seasonLength, periodsAhead, f.alpha, f.beta, f.gamma)
+}
+
+// holtWinters is additive triple exponential smoothing. The series must be
+// oldest-first and cover at least two full seasons.
+func holtWinters(series []float64, seasonLength, horizon int, alpha, beta, gamma float64) ([]float64, []float64) {
+	n := len(series)
+	m := seasonLength
+	seasons := n / m
+
+	firstSeasonAvg := mean(series[:m])
+	trend := (mean(series[m:2*m]) - firstSeasonAvg) / float64(m)
+	level := firstSeasonAvg + trend*float64(m-1)/2
+
+	seasonals := make([]float64, m)
+	for k := 0; k < seasons; k++ {
+		seasonAvg := mean(series[k*m : (k+1)*m])
+		for i := 0; i < m; i++ {
+			seasonals[i] += (series[k*m+i] - seasonAvg) / float64(seasons)
+		}
+	}
+
+	residuals := make([]float64, 0, n-m)
+	for t := m; t < n; t++ {
+		idx := t % m
+		residuals = append(residuals, series[t]-(level+trend+seasonals[idx]))
+		lastLevel := level
+		level = alpha*(series[t]-seasonals[idx]) + (1-alpha)*(level+trend)
+		trend = beta*(level-lastLevel) + (1-beta)*trend
+		seasonals[idx] = gamma*(series[t]-level) + (1-gamma)*seasonals[idx]
+	}
+
+	forecast := make([]float64, horizon)
+	for h := 1; h <= horizon; h++ {
+		value := level + float64(h)*trend + seasonals[(n+h-1)%m]
+		if value < 0 {
+			value = 0
+		}
+		forecast[h-1] = value
+	}
+
+	return forecast, residuals
+}
+
+// oneStepResiduals replays a window-based average over the series and
+// returns its one-step-ahead errors.
+func oneStepResiduals(series []float64, window int, average func([]float64) float64) []float64 {
+	if len(series) < 2 {
+		return nil
+	}
+
+	residuals := make([]float64, 0, len(series)-1)
+	for t := 1; t < len(series); t++ {
+		residuals = append(residuals, series[t]-average(tail(series[:t], window)))
+	}
+
+	return residuals
+}
+
+func weightedAverage(values []float64) float64 {
+	weightedSum := 0.0
+	totalWeight := 0.0
+	for i, v := range values {
+		weight := float64(i + 1)
+		weightedSum += v * weight
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return 0
+	}
+	return weightedSum / totalWeight
+}
+
+func tail(series []float64, window int) []float64 {
+	if window <= 0 || window >= len(series) {
+		return series
+	}
+	return series[len(series)-window:]
+}
+
+func repeat(value float64, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = value
+	}
+	return values
+}
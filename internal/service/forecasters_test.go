@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestForecasters_AllRegistered(t *testing.T) {
+	expected := []string{
+		ForecastMethodExponential,
+		ForecastMethodHolt,
+		ForecastMethodHoltWinters,
+		ForecastMethodLinear,
+		ForecastMethodSeasonalNaive,
+		ForecastMethodSMA,
+		ForecastMethodWMA,
+	}
+
+	methods := ForecastMethods()
+	if len(methods) != len(expected) {
+		t.Fatalf("expected %d methods, got %v", len(expected), methods)
+	}
+
+	for i, name := range expected {
+		if methods[i] != name {
+			t.Errorf("expected method %s at %d, got %s", name, i, methods[i])
+		}
+	}
+}
+
+func TestForecasters_ConstantSeries(t *testing.T) {
+	cfg := getSeasonalTestConfig()
+	series := repeat(50000, 24)
+
+	for _, name := range ForecastMethods() {
+		forecaster := forecasters[name](&cfg.Forecast, 12)
+
+		forecast, residuals := forecaster.Forecast(series, 3)
+
+		if forecaster.Name() != name {
+			t.Errorf("expected name %s, got %s", name, forecaster.Name())
+		}
+
+		if len(forecast) != 3 {
+			t.Fatalf("%s: expected 3 values, got %d", name, len(forecast))
+		}
+
+		for _, value := range forecast {
+			if math.Abs(value-50000) > 1 {
+				t.Errorf("%s: expected 50000 for constant series, got %.2f", name, value)
+			}
+		}
+
+		if rootMeanSquare(residuals) > 1 {
+			t.Errorf("%s: expected zero residuals for constant series", name)
+		}
+	}
+}
+
+func TestSMAForecaster_UsesWindow(t *testing.T) {
+	forecaster := &smaForecaster{window: 2}
+
+	forecast, _ := forecaster.Forecast([]float64{10, 20, 30, 40}, 1)
+
+	if forecast[0] != 35 {
+		t.Errorf("expected 35, got %.2f", forecast[0])
+	}
+}
+
+func TestExponentialForecaster_Smooths(t *testing.T) {
+	forecaster := &exponentialForecaster{alpha: 0.5}
+
+	forecast, residuals := forecaster.Forecast([]float64{100, 200}, 1)
+
+	if forecast[0] != 150 {
+		t.Errorf("expected 150, got %.2f", forecast[0])
+	}
+
+	if len(residuals) != 1 || residuals[0] != 100 {
+		t.Errorf("expected single residual 100, got %v", residuals)
+	}
+}
+
+func TestLinearForecaster_ExtendsTrend(t *testing.T) {
+	forecaster := &linearForecaster{}
+
+	forecast, _ := forecaster.Forecast([]float64{100, 200, 300, 400}, 2)
+
+	if math.Abs(forecast[0]-500) > 1e-6 || math.Abs(forecast[1]-600) > 1e-6 {
+		t.Errorf("expected [500 600], got %v", forecast)
+	}
+}
+
+func TestLinearForecaster_OneStepAheadResiduals(t *testing.T) {
+	forecaster := &linearForecaster{}
+
+	_, residuals := forecaster.Forecast([]float64{100, 200, 300, 400}, 1)
+
+	// The first step is predicted from a single period, so it misses the
+	// trend; later steps extend the line fitted so far and hit exactly.
+	expected := []float64{100, 0, 0}
+	if len(residuals) != len(expected) {
+		t.Fatalf("expected %d residuals, got %v", len(expected), residuals)
+	}
+	for i := range expected {
+		if math.Abs(residuals[i]-expected[i]) > 1e-6 {
+			t.Errorf("expected residuals %v, got %v", expected, residuals)
+			break
+		}
+	}
+}
+
+func TestHoltForecaster_ExtendsTrend(t *testing.T) {
+	forecaster := &holtForecaster{alpha: 0.5, beta: 0.3}
+
+	forecast, residuals := forecaster.Forecast([]float64{100, 200, 300, 400}, 2)
+
+	if math.Abs(forecast[0]-500) > 1e-6 || math.Abs(forecast[1]-600) > 1e-6 {
+		t.Errorf("expected [500 600], got %v", forecast)
+	}
+
+	if rootMeanSquare(residuals) > 1e-6 {
+		t.Errorf("expected zero residuals on a straight line, got %v", residuals)
+	}
+}
+
+func TestHoltForecaster_TracksGrowthBetterThanWMA(t *testing.T) {
+	series := []float64{100000, 104000, 107000, 112000, 115000, 120000}
+
+	holt, _ := (&holtForecaster{alpha: 0.5, beta: 0.3}).Forecast(series, 1)
+	wma, _ := newWMAForecaster(6).Forecast(series, 1)
+
+	if holt[0] <= series[len(series)-1] {
+		t.Errorf("expected Holt to project above the last value, got %.0f", holt[0])
+	}
+
+	if wma[0] >= holt[0] {
+		t.Errorf("expected WMA %.0f to lag behind Holt %.0f", wma[0], holt[0])
+	}
+}
+
+func TestSeasonalNaiveForecaster_RepeatsLastSeason(t *testing.T) {
+	forecaster := &seasonalNaiveForecaster{seasonLength: 4}
+
+	forecast, _ := forecaster.Forecast([]float64{1, 2, 3, 4, 5, 6, 7, 8}, 5)
+
+	expected := []float64{5, 6, 7, 8, 5}
+	for i := range expected {
+		if forecast[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, forecast)
+			break
+		}
+	}
+}
+
+func TestSeasonalForecasters_UnavailableWithoutSeason(t *testing.T) {
+	cfg := getSeasonalTestConfig()
+
+	for _, name := range []string{ForecastMethodSeasonalNaive, ForecastMethodHoltWinters} {
+		forecaster := forecasters[name](&cfg.Forecast, seasonLength(period.New(models.TimePeriodYear)))
+		if forecaster.MinPeriods() != math.MaxInt32 {
+			t.Errorf("%s should not be usable for yearly periods", name)
+		}
+	}
+}
+
+func TestGetForecast_MethodFromConfig(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.Method = ForecastMethodLinear
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Income: 130000, Expense: 60000},
+			{PeriodStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), Income: 120000, Expense: 60000},
+			{PeriodStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Income: 110000, Expense: 60000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 2, "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Method != ForecastMethodLinear {
+		t.Errorf("expected method %s, got %s", ForecastMethodLinear, result.Method)
+	}
+
+	if result.Forecasts[0].Income != 140000 || result.Forecasts[1].Income != 150000 {
+		t.Errorf("expected linear income 140000 and 150000, got %d and %d", result.Forecasts[0].Income, result.Forecasts[1].Income)
+	}
+}
+
+func TestGetForecast_RequestOverridesConfig(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 40000},
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 60000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, ForecastMethodSMA)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Method != ForecastMethodSMA {
+		t.Errorf("expected method %s, got %s", ForecastMethodSMA, result.Method)
+	}
+
+	if result.Forecasts[0].Expense != 50000 {
+		t.Errorf("expected SMA expense 50000, got %d", result.Forecasts[0].Expense)
+	}
+}
+
+func TestGetForecast_UnknownMethod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	service := NewAnalyzerService(storage.NewMockStorage(), logger, cfg)
+
+	_, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "arima")
+
+	if err == nil {
+		t.Fatal("expected error for unknown method, got nil")
+	}
+
+	expectedMsg := "unknown forecast method: arima"
+	if err.Error() != expectedMsg {
+		t.Errorf("expected error message '%s', got '%s'", expectedMsg, err.Error())
+	}
+}
+
+func TestGetForecast_SeasonalMethodFallsBackToWMA(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+			{PeriodStart: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), Income: 95000, Expense: 48000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, ForecastMethodSeasonalNaive)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Method != ForecastMethodWMA {
+		t.Errorf("expected fallback to %s, got %s", ForecastMethodWMA, result.Method)
+	}
+}
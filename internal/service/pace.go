@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+const (
+	defaultPaceLookbackPeriods    = 6
+	defaultPaceMinElapsedFraction = 0.1
+	defaultPaceOverspendPercent   = 10.0
+)
+
+// GetSpendingPace projects each category's total for the current period
+// from the spend so far and the share of the period total the user usually
+// spends by this point. Categories on track to exceed their WMA expectation
+// are flagged; the result is sorted by pace ratio, fastest first.
+func (s *AnalyzerService) GetSpendingPace(ctx context.Context, userID string, unit models.TimePeriod) (*models.SpendingPace, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	if unit == "" {
+		unit = models.TimePeriodMonth
+	}
+
+	period, err := s.resolvePeriod(ctx, userID, unit)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := s.cfg.Pace
+	lookbackPeriods := cfg.LookbackPeriods
+	if lookbackPeriods <= 0 {
+		lookbackPeriods = defaultPaceLookbackPeriods
+	}
+	minElapsedFraction := cfg.MinElapsedFraction
+	if minElapsedFraction <= 0 {
+		minElapsedFraction = defaultPaceMinElapsedFraction
+	}
+	overspendPercent := cfg.OverspendPercent
+	if overspendPercent <= 0 {
+		overspendPercent = defaultPaceOverspendPercent
+	}
+
+	now := s.now()
+	currentStart := period.Truncate(now)
+	elapsed := elapsedFraction(now, period)
+	startDate := period.Add(currentStart, -lookbackPeriods)
+
+	transactions, err := s.storage.GetTransactions(ctx, storage.GetTransactionsRequest{
+		UserID:    userID,
+		StartDate: startDate,
+		EndDate:   now,
+		Type:      models.TransactionTypeExpense,
+	})
+	if err != nil {
+		s.logger.Error("failed to get transactions", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	spent := make(map[string]int64)
+	totals := make(map[time.Time]map[string]int64)
+	early := make(map[string]int64)
+	for _, tx := range transactions {
+		mcc := transactionMCC(tx)
+		start := period.Truncate(tx.CreatedAt)
+		if !start.Before(currentStart) {
+			spent[mcc] += tx.Amount
+			continue
+		}
+
+		if totals[start] == nil {
+			totals[start] = make(map[string]int64)
+		}
+		totals[start][mcc] += tx.Amount
+
+		length := period.Next(start).Sub(start)
+		if float64(tx.CreatedAt.Sub(start)) <= elapsed*float64(length) {
+			early[mcc] += tx.Amount
+		}
+	}
+
+	// Every past period counts, including those without spending, so the
+	// expectation matches what the user really spends per period.
+	history := period.Enumerate(startDate, currentStart.Add(-time.Nanosecond))
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].After(history[j])
+	})
+	weights := wmaWeights(len(history))
+
+	expected := make(map[string]float64)
+	historyTotal := make(map[string]int64)
+	for i, start := range history {
+		for mcc, amount := range totals[start] {
+			expected[mcc] += float64(amount) * weights[i]
+			historyTotal[mcc] += amount
+		}
+	}
+
+	result := &models.SpendingPace{
+		PeriodStart:     currentStart,
+		PeriodEnd:       period.End(currentStart),
+		ElapsedFraction: elapsed,
+	}
+
+	for mcc, expectedAmount := range expected {
+		if expectedAmount <= 0 {
+			continue
+		}
+
+		share := float64(early[mcc]) / float64(historyTotal[mcc])
+		projected := float64(spent[mcc]) + expectedAmount*(1-share)
+
+		pace := models.CategoryPace{
+			MCC:             mcc,
+			SpentAmount:     spent[mcc],
+			ExpectedAmount:  int64(expectedAmount),
+			ProjectedAmount: int64(projected),
+			TypicalShare:    share,
+		}
+		if typical := expectedAmount * share; typical > 0 {
+			pace.PaceRatio = float64(spent[mcc]) / typical
+		}
+		pace.Exceeding = elapsed >= minElapsedFraction && projected > expectedAmount*(1+overspendPercent/100)
+
+		if pace.Exceeding {
+			s.logger.Info("category on track to exceed",
+				"user_id", userID,
+				"mcc", mcc,
+				"spent", pace.SpentAmount,
+				"expected", pace.ExpectedAmount,
+				"projected", pace.ProjectedAmount,
+				"pace_ratio", pace.PaceRatio,
+			)
+		}
+
+		result.Categories = append(result.Categories, pace)
+	}
+
+	sort.Slice(result.Categories, func(i, j int) bool {
+		if result.Categories[i].PaceRatio != result.Categories[j].PaceRatio {
+			return result.Categories[i].PaceRatio > result.Categories[j].PaceRatio
+		}
+		return result.Categories[i].MCC < result.Categories[j].MCC
+	})
+
+	s.logger.Info("spending pace calculated",
+		"user_id", userID,
+		"period", period,
+		"period_start", currentStart,
+		"elapsed_fraction", elapsed,
+		"categories_count", len(result.Categories),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+// paceHistory spreads groceries over each month in thirds and splits
+// restaurants between the 3rd and the 20th, for April and May 2024.
+func paceHistory() []models.Transaction {
+	var transactions []models.Transaction
+	for _, month := range []time.Month{time.April, time.May} {
+		for _, day := range []int{5, 15, 25} {
+			transactions = append(transactions, expenseAt("groceries", 5411, 10000, "Market", time.Date(2024, month, day, 12, 0, 0, 0, time.UTC)))
+		}
+		transactions = append(transactions,
+			expenseAt("lunch", 5812, 3000, "Cafe", time.Date(2024, month, 3, 13, 0, 0, 0, time.UTC)),
+			expenseAt("dinner", 5812, 3000, "Cafe", time.Date(2024, month, 20, 19, 0, 0, 0, time.UTC)),
+		)
+	}
+	return transactions
+}
+
+func TestGetSpendingPace(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+
+	transactions := append(paceHistory(),
+		expenseAt("groceries", 5411, 15000, "Market", time.Date(2024, 6, 5, 12, 0, 0, 0, time.UTC)),
+		expenseAt("groceries", 5411, 10000, "Market", time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)),
+		expenseAt("lunch", 5812, 3000, "Cafe", time.Date(2024, 6, 3, 13, 0, 0, 0, time.UTC)),
+	)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		if req.Type != models.TransactionTypeExpense || !req.EndDate.Equal(now) {
+			t.Errorf("expected expenses up to now, got %s until %v", req.Type, req.EndDate)
+		}
+		return transactions, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Pace.LookbackPeriods = 2
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return now }
+
+	pace, err := service.GetSpendingPace(context.Background(), "user-123", models.TimePeriodMonth)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !pace.PeriodStart.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected June, got %v", pace.PeriodStart)
+	}
+	if len(pace.Categories) != 2 {
+		t.Fatalf("expected two categories, got %+v", pace.Categories)
+	}
+
+	groceries := pace.Categories[0]
+	if groceries.MCC != "5411" || !groceries.Exceeding {
+		t.Errorf("expected groceries on track to exceed, got %+v", groceries)
+	}
+	if groceries.ExpectedAmount != 30000 || groceries.ProjectedAmount != 45000 {
+		t.Errorf("expected 45000 projected against 30000, got %d against %d", groceries.ProjectedAmount, groceries.ExpectedAmount)
+	}
+	if groceries.PaceRatio != 2.5 {
+		t.Errorf("expected pace ratio 2.5, got %v", groceries.PaceRatio)
+	}
+
+	restaurants := pace.Categories[1]
+	if restaurants.Exceeding || restaurants.PaceRatio != 1 {
+		t.Errorf("expected restaurants on pace, got %+v", restaurants)
+	}
+}
+
+func TestGetSpendingPace_TooEarly(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	transactions := append(paceHistory(),
+		expenseAt("groceries", 5411, 40000, "Market", time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+	)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		return transactions, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Pace.LookbackPeriods = 2
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC) }
+
+	pace, err := service.GetSpendingPace(context.Background(), "user-123", models.TimePeriodMonth)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	for _, c := range pace.Categories {
+		if c.Exceeding {
+			t.Errorf("expected no alerts before min_elapsed_fraction, got %+v", c)
+		}
+	}
+}
+
+func TestGetSpendingPace_Errors(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		return nil, errors.New("database error")
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+
+	if _, err := service.GetSpendingPace(context.Background(), "", models.TimePeriodMonth); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	if _, err := service.GetSpendingPace(context.Background(), "user-123", models.TimePeriodMonth); err == nil {
+		t.Error("expected storage error to be returned")
+	}
+}
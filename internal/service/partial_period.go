@@ -0,0 +1,91 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+const (
+	PartialPeriodInclude = "include"
+	PartialPeriodExclude = "exclude"
+	PartialPeriodProrate = "prorate"
+)
+
+// elapsedFraction is the share of the period containing now that has passed.
+func elapsedFraction(now time.Time, period period.Period) float64 {
+	start := period.Truncate(now)
+	next := period.Next(start)
+	return now.Sub(start).Seconds() / next.Sub(start).Seconds()
+}
+
+// partialPeriodScale decides what happens to the unfinished current period:
+// it is dropped when keep is false, otherwise its amounts are multiplied by
+// scale.
+func partialPeriodScale(cfg config.PartialPeriodConfig, now time.Time, period period.Period) (float64, bool) {
+	switch cfg.Mode {
+	case PartialPeriodExclude:
+		return 0, false
+	case PartialPeriodProrate:
+		fraction := elapsedFraction(now, period)
+		if fraction <= 0 || fraction < cfg.MinElapsedFraction {
+			return 0, false
+		}
+		return 1 / fraction, true
+	default:
+		return 1, true
+	}
+}
+
+// partialPeriodLookback adds one period to the query when the current period
+// may be dropped, so the baseline still spans lookbackPeriods full periods.
+func partialPeriodLookback(cfg config.PartialPeriodConfig, lookbackPeriods int) int {
+	if cfg.Mode == PartialPeriodExclude || cfg.Mode == PartialPeriodProrate {
+		return lookbackPeriods + 1
+	}
+	return lookbackPeriods
+}
+
+// adjustPartialPeriod applies the partial period rule to storage output
+// (newest period first) and trims it back to lookbackPeriods.
+func adjustPartialPeriod(historical []models.PeriodStats, currentPeriodStart time.Time, scale float64, keep bool, lookbackPeriods int) []models.PeriodStats {
+	if len(historical) > 0 && !historical[0].PeriodStart.Before(currentPeriodStart) {
+		if !keep {
+			historical = historical[1:]
+		} else if scale != 1 {
+			current := historical[0]
+			current.Income = int64(math.Round(float64(current.Income) * scale))
+			current.Expense = int64(math.Round(float64(current.Expense) * scale))
+			current.Balance = current.Income - current.Expense
+			historical = append([]models.PeriodStats{current}, historical[1:]...)
+		}
+	}
+
+	if len(historical) > lookbackPeriods {
+		historical = historical[:lookbackPeriods]
+	}
+
+	return historical
+}
+
+func adjustPartialCategoryStats(stats []models.CategoryPeriodStats, currentPeriodStart time.Time, scale float64, keep bool) []models.CategoryPeriodStats {
+	if keep && scale == 1 {
+		return stats
+	}
+
+	adjusted := make([]models.CategoryPeriodStats, 0, len(stats))
+	for _, stat := range stats {
+		if !stat.PeriodStart.Before(currentPeriodStart) {
+			if !keep {
+				continue
+			}
+			stat.Amount = int64(math.Round(float64(stat.Amount) * scale))
+		}
+		adjusted = append(adjusted, stat)
+	}
+
+	return adjusted
+}
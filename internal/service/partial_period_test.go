@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestElapsedFraction(t *testing.T) {
+	now := time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC)
+
+	fraction := elapsedFraction(now, period.New(models.TimePeriodMonth))
+
+	if math.Abs(fraction-0.5) > 1e-6 {
+		t.Errorf("expected half of June elapsed, got %v", fraction)
+	}
+}
+
+func TestPartialPeriodScale(t *testing.T) {
+	now := time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		cfg   config.PartialPeriodConfig
+		scale float64
+		keep  bool
+	}{
+		{"include by default", config.PartialPeriodConfig{}, 1, true},
+		{"exclude", config.PartialPeriodConfig{Mode: PartialPeriodExclude}, 0, false},
+		{"prorate", config.PartialPeriodConfig{Mode: PartialPeriodProrate, MinElapsedFraction: 0.25}, 3, true},
+		{"prorate too early", config.PartialPeriodConfig{Mode: PartialPeriodProrate, MinElapsedFraction: 0.5}, 0, false},
+	}
+
+	for _, tt := range tests {
+		scale, keep := partialPeriodScale(tt.cfg, now, period.New(models.TimePeriodMonth))
+		if keep != tt.keep || math.Abs(scale-tt.scale) > 1e-6 {
+			t.Errorf("%s: expected (%v, %v), got (%v, %v)", tt.name, tt.scale, tt.keep, scale, keep)
+		}
+	}
+}
+
+func TestAdjustPartialPeriod(t *testing.T) {
+	current := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	historical := []models.PeriodStats{
+		{PeriodStart: current, Income: 10000, Expense: 20000},
+		{PeriodStart: current.AddDate(0, -1, 0), Income: 100000, Expense: 60000},
+		{PeriodStart: current.AddDate(0, -2, 0), Income: 100000, Expense: 50000},
+	}
+
+	excluded := adjustPartialPeriod(historical, current, 0, false, 2)
+	if len(excluded) != 2 || !excluded[0].PeriodStart.Equal(current.AddDate(0, -1, 0)) {
+		t.Errorf("expected current period dropped, got %+v", excluded)
+	}
+
+	prorated := adjustPartialPeriod(historical, current, 3, true, 2)
+	if len(prorated) != 2 {
+		t.Fatalf("expected 2 periods after trimming, got %d", len(prorated))
+	}
+	if prorated[0].Expense != 60000 || prorated[0].Balance != -30000 {
+		t.Errorf("expected prorated expense 60000 and balance -30000, got %d and %d", prorated[0].Expense, prorated[0].Balance)
+	}
+	if historical[0].Expense != 20000 {
+		t.Error("storage data should not be modified")
+	}
+}
+
+func TestGetForecast_ExcludesPartialPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Forecast.PartialPeriod = config.PartialPeriodConfig{Mode: PartialPeriodExclude}
+
+	current := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Periods != 7 {
+			t.Errorf("expected one extra period to be fetched, got %d", req.Periods)
+		}
+		return []models.PeriodStats{
+			{PeriodStart: current, Income: 0, Expense: 5000},
+			{PeriodStart: current.AddDate(0, -1, 0), Income: 100000, Expense: 50000},
+			{PeriodStart: current.AddDate(0, -2, 0), Income: 100000, Expense: 50000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodMonth, 1, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	f := result.Forecasts[0]
+	if f.Expense != 50000 || f.Income != 100000 {
+		t.Errorf("expected forecast from full months only, got income %d expense %d", f.Income, f.Expense)
+	}
+
+	if !f.PeriodStart.Equal(current) {
+		t.Errorf("expected forecast for the current period %v, got %v", current, f.PeriodStart)
+	}
+}
+
+func TestGetAnomalies_ProratesPartialPeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.PartialPeriod = config.PartialPeriodConfig{Mode: PartialPeriodProrate, MinElapsedFraction: 0.25}
+
+	current := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		return []models.CategoryPeriodStats{
+			{PeriodStart: current, CategoryID: "5411", Amount: 20000},
+			{PeriodStart: current, CategoryID: "5812", Amount: 1500},
+			{PeriodStart: current.AddDate(0, -1, 0), CategoryID: "5411", Amount: 30000},
+			{PeriodStart: current.AddDate(0, -1, 0), CategoryID: "5812", Amount: 4000},
+			{PeriodStart: current.AddDate(0, -2, 0), CategoryID: "5411", Amount: 30000},
+			{PeriodStart: current.AddDate(0, -2, 0), CategoryID: "5812", Amount: 4000},
+		}, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC) }
+
+	anomalies, _, err := service.GetAnomalies(context.Background(), "user-123", models.TimePeriodMonth, time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 1 {
+		t.Fatalf("expected 1 anomaly, got %d", len(anomalies))
+	}
+
+	if anomalies[0].MCC != "5411" || anomalies[0].ActualAmount != 60000 {
+		t.Errorf("expected groceries prorated to 60000, got %s %d", anomalies[0].MCC, anomalies[0].ActualAmount)
+	}
+}
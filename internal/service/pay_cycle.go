@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+)
+
+// payCycleAnchorDay returns the day of month the user's pay cycles start on.
+func (s *AnalyzerService) payCycleAnchorDay(ctx context.Context, userID string) (int, error) {
+	anchorDay := s.cfg.PayCycle.AnchorDay
+	if s.cfg.PayCycle.AutoDetect {
+		patterns, err := s.storage.GetRecurringIncome(ctx, userID)
+		if err != nil {
+			s.logger.Error("failed to get recurring income", "error", err, "user_id", userID)
+			return 0, fmt.Errorf("failed to get recurring income: %w", err)
+		}
+
+		if payDay := detectPayDay(patterns); payDay > 0 {
+			anchorDay = payDay
+		}
+	}
+
+	return period.ClampAnchorDay(anchorDay), nil
+}
+
+// detectPayDay takes the day of month of the largest monthly recurring
+// income, which is almost always the salary; an annual bonus can be larger but
+// does not set the cycle. Returns 0 when there is no monthly recurring income.
+func detectPayDay(patterns []models.RecurringPattern) int {
+	payDay := 0
+	largest := int64(0)
+	for _, pattern := range patterns {
+		if pattern.Cadence != models.RecurringCadenceMonthly {
+			continue
+		}
+		if pattern.MedianAmount > largest {
+			largest = pattern.MedianAmount
+			payDay = pattern.LastOccurrence.Day()
+		}
+	}
+	return payDay
+}
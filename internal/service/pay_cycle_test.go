@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func TestDetectPayDay(t *testing.T) {
+	patterns := []models.RecurringPattern{
+		{MCC: "uncategorized", Cadence: models.RecurringCadenceMonthly, MedianAmount: 15000, LastOccurrence: time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)},
+		{MCC: "uncategorized", Cadence: models.RecurringCadenceMonthly, MedianAmount: 120000, LastOccurrence: time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	if got := detectPayDay(patterns); got != 25 {
+		t.Errorf("expected salary day 25, got %d", got)
+	}
+
+	if got := detectPayDay(nil); got != 0 {
+		t.Errorf("expected 0 without recurring income, got %d", got)
+	}
+}
+
+func TestDetectPayDay_IgnoresAnnualBonus(t *testing.T) {
+	patterns := []models.RecurringPattern{
+		{MCC: "uncategorized", Cadence: models.RecurringCadenceAnnual, MedianAmount: 500000, LastOccurrence: time.Date(2023, 12, 28, 0, 0, 0, 0, time.UTC)},
+		{MCC: "uncategorized", Cadence: models.RecurringCadenceMonthly, MedianAmount: 120000, LastOccurrence: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)},
+	}
+
+	if got := detectPayDay(patterns); got != 10 {
+		t.Errorf("expected salary day 10, not the bonus day, got %d", got)
+	}
+
+	if got := detectPayDay(patterns[:1]); got != 0 {
+		t.Errorf("expected 0 with only an annual bonus, got %d", got)
+	}
+}
+
+func TestPayCycleAnchorDay(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetRecurringIncomeFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return []models.RecurringPattern{
+			{MCC: "uncategorized", Cadence: models.RecurringCadenceMonthly, MedianAmount: 120000, AvgIntervalDays: 30, LastOccurrence: time.Date(2024, 5, 25, 0, 0, 0, 0, time.UTC)},
+		}, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.PayCycle.AnchorDay = 5
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	anchorDay, err := service.payCycleAnchorDay(context.Background(), "user-123")
+	if err != nil || anchorDay != 5 {
+		t.Errorf("expected configured anchor 5, got %d (%v)", anchorDay, err)
+	}
+
+	cfg.PayCycle.AutoDetect = true
+	anchorDay, err = service.payCycleAnchorDay(context.Background(), "user-123")
+	if err != nil || anchorDay != 25 {
+		t.Errorf("expected detected anchor 25, got %d (%v)", anchorDay, err)
+	}
+}
+
+func TestResolvePeriod(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetRecurringIncomeFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		t.Error("recurring income should only be loaded for pay cycles")
+		return nil, nil
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.PayCycle.AutoDetect = true
+	cfg.FiscalYear.StartMonth = 4
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	month, err := service.resolvePeriod(context.Background(), "user-123", models.TimePeriodMonth)
+	if err != nil || month != period.New(models.TimePeriodMonth) {
+		t.Errorf("expected plain calendar month, got %+v (%v)", month, err)
+	}
+
+	fiscal, err := service.resolvePeriod(context.Background(), "user-123", models.TimePeriodFiscalYear)
+	if err != nil || fiscal != period.FiscalYear(time.April) {
+		t.Errorf("expected fiscal year from April, got %+v (%v)", fiscal, err)
+	}
+}
+
+func TestPayCycleAnchorDay_StorageError(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetRecurringIncomeFunc = func(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+		return nil, errors.New("database error")
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.PayCycle.AutoDetect = true
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	if _, err := service.payCycleAnchorDay(context.Background(), "user-123"); err == nil {
+		t.Error("expected error when recurring income cannot be loaded")
+	}
+}
+
+func TestGetForecast_PayCycle(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	cfg := getDefaultTestConfig()
+	cfg.PayCycle.AnchorDay = 10
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsForForecastFunc = func(ctx context.Context, req storage.GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+		if req.Period != period.PayCycle(10) {
+			t.Errorf("expected pay cycle anchored on day 10, got %s", req.Period)
+		}
+		if req.StartDate.Day() != 10 {
+			t.Errorf("expected lookback to start on the pay day, got %v", req.StartDate)
+		}
+		return []models.PeriodStats{
+			{PeriodStart: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 60000},
+			{PeriodStart: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC), Income: 100000, Expense: 50000},
+		}, nil
+	}
+	mockStorage.GetCategoryStatsByPeriodsFunc = func(ctx context.Context, req storage.GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+		if req.Period != period.PayCycle(10) {
+			t.Errorf("expected category stats anchored on day 10, got %s", req.Period)
+		}
+		return nil, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+	service.now = func() time.Time { return time.Date(2024, 6, 5, 12, 0, 0, 0, time.UTC) }
+
+	result, err := service.GetForecast(context.Background(), "user-123", models.TimePeriodPayCycle, 2, "wma")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := []time.Time{
+		time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 7, 10, 0, 0, 0, 0, time.UTC),
+	}
+	for i, f := range result.Forecasts {
+		if !f.PeriodStart.Equal(expected[i]) {
+			t.Errorf("forecast %d: expected cycle starting %v, got %v", i, expected[i], f.PeriodStart)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+const (
+	defaultTransactionLookbackMonths = 6
+	defaultTransactionRecentDays     = 7
+	defaultTransactionMinHistory     = 5
+	defaultAmountPercentile          = 95.0
+	defaultUnusualHourShare          = 5.0
+	defaultTransactionMinScore       = 1.0
+
+	// An outsized amount is enough on its own; an odd hour or a new merchant
+	// only counts together with another reason.
+	amountReasonWeight            = 1.0
+	unusualHourReasonWeight       = 0.5
+	firstTimeMerchantReasonWeight = 0.5
+
+	unusualHourWindowHours = 1
+	uncategorizedMCC       = "uncategorized"
+)
+
+// GetTransactionAnomalies scores the user's expenses from the last days days
+// against their earlier expenses in the same MCC and returns the ones that
+// stand out, highest score first.
+func (s *AnalyzerService) GetTransactionAnomalies(ctx context.Context, userID string, days int) ([]models.TransactionAnomaly, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	cfg := s.cfg.Anomaly.Transactions
+
+	if days <= 0 {
+		days = cfg.RecentDays
+	}
+	if days <= 0 {
+		days = defaultTransactionRecentDays
+	}
+	if cfg.MaxRecentDays > 0 && days > cfg.MaxRecentDays {
+		return nil, fmt.Errorf("days cannot exceed %d", cfg.MaxRecentDays)
+	}
+
+	lookbackMonths := cfg.LookbackMonths
+	if lookbackMonths <= 0 {
+		lookbackMonths = defaultTransactionLookbackMonths
+	}
+
+	now := s.now()
+	recentStart := now.AddDate(0, 0, -days)
+
+	transactions, err := s.storage.GetTransactions(ctx, storage.GetTransactionsRequest{
+		UserID:    userID,
+		StartDate: recentStart.AddDate(0, -lookbackMonths, 0),
+		EndDate:   now,
+		Type:      models.TransactionTypeExpense,
+	})
+	if err != nil {
+		s.logger.Error("failed to get transactions", "error", err, "user_id", userID)
+		return nil, fmt.Errorf("failed to get transactions: %w", err)
+	}
+
+	history := newTransactionHistory()
+	var recent []models.Transaction
+	for _, tx := range transactions {
+		if tx.CreatedAt.Before(recentStart) {
+			history.add(tx)
+		} else {
+			recent = append(recent, tx)
+		}
+	}
+
+	minScore := cfg.MinScore
+	if minScore <= 0 {
+		minScore = defaultTransactionMinScore
+	}
+
+	var anomalies []models.TransactionAnomaly
+	for _, tx := range recent {
+		anomaly := s.scoreTransaction(history, tx)
+		history.addMerchant(tx)
+
+		if anomaly.Score >= minScore {
+			anomalies = append(anomalies, anomaly)
+		}
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		if anomalies[i].Score != anomalies[j].Score {
+			return anomalies[i].Score > anomalies[j].Score
+		}
+		return anomalies[i].Transaction.Amount > anomalies[j].Transaction.Amount
+	})
+
+	s.logger.Info("transaction anomalies detected",
+		"user_id", userID,
+		"days", days,
+		"history_transactions", len(transactions)-len(recent),
+		"recent_transactions", len(recent),
+		"anomalies_count", len(anomalies),
+	)
+
+	return anomalies, nil
+}
+
+func (s *AnalyzerService) scoreTransaction(history *transactionHistory, tx models.Transaction) models.TransactionAnomaly {
+	cfg := s.cfg.Anomaly.Transactions
+
+	minHistory := cfg.MinHistory
+	if minHistory <= 0 {
+		minHistory = defaultTransactionMinHistory
+	}
+	amountPercentile := cfg.AmountPercentile
+	if amountPercentile <= 0 {
+		amountPercentile = defaultAmountPercentile
+	}
+	unusualHourShare := cfg.UnusualHourShare
+	if unusualHourShare <= 0 {
+		unusualHourShare = defaultUnusualHourShare
+	}
+
+	anomaly := models.TransactionAnomaly{Transaction: tx}
+
+	same := history.byMCC[transactionMCC(tx)]
+	if len(same) >= minHistory {
+		anomaly.AmountPercentile = percentileRank(same, tx.Amount)
+		if anomaly.AmountPercentile >= amountPercentile {
+			anomaly.Score += amountReasonWeight
+			anomaly.Reasons = append(anomaly.Reasons, models.TransactionAnomalyReasonAmount)
+		}
+
+		if hourShare(same, tx.CreatedAt.Hour())*100 < unusualHourShare {
+			anomaly.Score += unusualHourReasonWeight
+			anomaly.Reasons = append(anomaly.Reasons, models.TransactionAnomalyReasonUnusualHour)
+		}
+	}
+
+	if merchant := merchantKey(tx.Description); merchant != "" && !history.merchants[merchant] {
+		anomaly.Score += firstTimeMerchantReasonWeight
+		anomaly.Reasons = append(anomaly.Reasons, models.TransactionAnomalyReasonFirstTimeMerchant)
+	}
+
+	return anomaly
+}
+
+// transactionHistory indexes earlier expenses by MCC and by merchant.
+type transactionHistory struct {
+	byMCC     map[string][]models.Transaction
+	merchants map[string]bool
+}
+
+func newTransactionHistory() *transactionHistory {
+	return &transactionHistory{
+		byMCC:     make(map[string][]models.Transaction),
+		merchants: make(map[string]bool),
+	}
+}
+
+func (h *transactionHistory) add(tx models.Transaction) {
+	mcc := transactionMCC(tx)
+	h.byMCC[mcc] = append(h.byMCC[mcc], tx)
+	h.addMerchant(tx)
+}
+
+func (h *transactionHistory) addMerchant(tx models.Transaction) {
+	if merchant := merchantKey(tx.Description); merchant != "" {
+		h.merchants[merchant] = true
+	}
+}
+
+func transactionMCC(tx models.Transaction) string {
+	if tx.MCC == nil {
+		return uncategorizedMCC
+	}
+	return strconv.Itoa(int(*tx.MCC))
+}
+
+func merchantKey(description string) string {
+	return strings.ToLower(strings.Join(strings.Fields(description), " "))
+}
+
+// percentileRank is the share of history below amount, counting ties as
+// half, in percent.
+func percentileRank(history []models.Transaction, amount int64) float64 {
+	below := 0.0
+	for _, tx := range history {
+		switch {
+		case tx.Amount < amount:
+			below++
+		case tx.Amount == amount:
+			below += 0.5
+		}
+	}
+	return below / float64(len(history)) * 100
+}
+
+// hourShare is the share of history within unusualHourWindowHours of hour,
+// wrapping around midnight.
+func hourShare(history []models.Transaction, hour int) float64 {
+	near := 0
+	for _, tx := range history {
+		diff := tx.CreatedAt.Hour() - hour
+		if diff < 0 {
+			diff = -diff
+		}
+		if min(diff, 24-diff) <= unusualHourWindowHours {
+			near++
+		}
+	}
+	return float64(near) / float64(len(history))
+}
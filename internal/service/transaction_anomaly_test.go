@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/storage"
+)
+
+func expenseAt(id string, mcc int32, amount int64, description string, createdAt time.Time) models.Transaction {
+	return models.Transaction{
+		ID:          id,
+		Type:        models.TransactionTypeExpense,
+		Amount:      amount,
+		Currency:    "RUB",
+		MCC:         &mcc,
+		Description: description,
+		CreatedAt:   createdAt,
+	}
+}
+
+func TestPercentileRank(t *testing.T) {
+	history := []models.Transaction{{Amount: 100}, {Amount: 200}, {Amount: 300}, {Amount: 400}}
+
+	if got := percentileRank(history, 500); got != 100 {
+		t.Errorf("expected 100 above every amount, got %v", got)
+	}
+	if got := percentileRank(history, 200); math.Abs(got-37.5) > 1e-9 {
+		t.Errorf("expected ties to count as half, got %v", got)
+	}
+}
+
+func TestHourShare(t *testing.T) {
+	at := func(hour int) models.Transaction {
+		return models.Transaction{CreatedAt: time.Date(2024, 5, 1, hour, 0, 0, 0, time.UTC)}
+	}
+	history := []models.Transaction{at(23), at(12), at(13), at(14)}
+
+	if got := hourShare(history, 0); got != 0.25 {
+		t.Errorf("expected 23:00 to count for midnight, got %v", got)
+	}
+	if got := hourShare(history, 4); got != 0 {
+		t.Errorf("expected no purchases near 4:00, got %v", got)
+	}
+}
+
+func TestMerchantKey(t *testing.T) {
+	if merchantKey("  Coffee   HOUSE ") != merchantKey("coffee house") {
+		t.Error("expected case and whitespace to be ignored")
+	}
+}
+
+func TestGetTransactionAnomalies(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	now := time.Date(2024, 6, 15, 20, 0, 0, 0, time.UTC)
+
+	var transactions []models.Transaction
+	for i := 0; i < 20; i++ {
+		createdAt := time.Date(2024, 5, 1+i, 13, 0, 0, 0, time.UTC)
+		transactions = append(transactions, expenseAt("lunch", 5812, 50000+int64(i)*1000, "Cafe", createdAt))
+	}
+	transactions = append(transactions,
+		expenseAt("normal", 5812, 60000, "Cafe", time.Date(2024, 6, 12, 13, 0, 0, 0, time.UTC)),
+		expenseAt("banquet", 5812, 400000, "Cafe", time.Date(2024, 6, 13, 14, 0, 0, 0, time.UTC)),
+		expenseAt("night", 5812, 55000, "Night Bar", time.Date(2024, 6, 14, 3, 0, 0, 0, time.UTC)),
+		expenseAt("new", 5812, 55000, "Bistro", time.Date(2024, 6, 14, 13, 0, 0, 0, time.UTC)),
+	)
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		if req.Type != models.TransactionTypeExpense {
+			t.Errorf("expected expenses only, got %s", req.Type)
+		}
+		if !req.EndDate.Equal(now) {
+			t.Errorf("expected window to end now, got %v", req.EndDate)
+		}
+		return transactions, nil
+	}
+
+	service := NewAnalyzerService(mockStorage, logger, getDefaultTestConfig())
+	service.now = func() time.Time { return now }
+
+	anomalies, err := service.GetTransactionAnomalies(context.Background(), "user-123", 7)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(anomalies) != 2 {
+		t.Fatalf("expected the banquet and the night bar, got %+v", anomalies)
+	}
+	if anomalies[0].Transaction.ID != "banquet" || anomalies[0].AmountPercentile != 100 {
+		t.Errorf("expected banquet first at the 100th percentile, got %+v", anomalies[0])
+	}
+	if anomalies[1].Transaction.ID != "night" || len(anomalies[1].Reasons) != 2 {
+		t.Errorf("expected night bar flagged for hour and merchant, got %+v", anomalies[1])
+	}
+}
+
+func TestGetTransactionAnomalies_Validation(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+	mockStorage := storage.NewMockStorage()
+	mockStorage.GetTransactionsFunc = func(ctx context.Context, req storage.GetTransactionsRequest) ([]models.Transaction, error) {
+		return nil, errors.New("database error")
+	}
+
+	cfg := getDefaultTestConfig()
+	cfg.Anomaly.Transactions.MaxRecentDays = 30
+	service := NewAnalyzerService(mockStorage, logger, cfg)
+
+	if _, err := service.GetTransactionAnomalies(context.Background(), "", 7); err == nil {
+		t.Error("expected error for empty user_id")
+	}
+	if _, err := service.GetTransactionAnomalies(context.Background(), "user-123", 31); err == nil {
+		t.Error("expected error when days exceed the maximum")
+	}
+	if _, err := service.GetTransactionAnomalies(context.Background(), "user-123", 7); err == nil {
+		t.Error("expected storage error to be returned")
+	}
+}
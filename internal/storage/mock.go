@@ -2,16 +2,23 @@ package storage
 
 import (
 	"context"
-	"time"
 
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
 )
 
 type MockStorage struct {
-	GetStatisticsFunc              func(ctx context.Context, req GetStatisticsRequest) ([]models.PeriodStats, error)
-	GetTransactionsForForecastFunc func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error)
-	GetCategoryStatsByPeriodsFunc  func(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error)
-	GetRecurringPatternsFunc       func(ctx context.Context, userID string) ([]models.RecurringPattern, error)
+	GetStatisticsFunc                func(ctx context.Context, req GetStatisticsRequest) ([]models.PeriodStats, error)
+	GetTransactionsForForecastFunc   func(ctx context.Context, req GetTransactionsForForecastRequest) ([]models.PeriodStats, error)
+	GetCategoryStatsByPeriodsFunc    func(ctx context.Context, req GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error)
+	GetRecurringPatternsFunc         func(ctx context.Context, userID string) ([]models.RecurringPattern, error)
+	GetRecurringIncomeFunc           func(ctx context.Context, userID string) ([]models.RecurringPattern, error)
+	GetCurrentBalanceFunc            func(ctx context.Context, userID string) (int64, error)
+	GetTransactionsFunc              func(ctx context.Context, req GetTransactionsRequest) ([]models.Transaction, error)
+	SaveAnomalyAcknowledgementFunc   func(ctx context.Context, ack models.AnomalyAcknowledgement) error
+	GetAnomalyAcknowledgementsFunc   func(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error)
+	DeleteAnomalyAcknowledgementFunc func(ctx context.Context, ack models.AnomalyAcknowledgement) error
+	SaveAnomalyThresholdOverrideFunc func(ctx context.Context, override models.AnomalyThresholdOverride) error
+	GetAnomalyThresholdOverrideFunc  func(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error)
 }
 
 func NewMockStorage() *MockStorage {
@@ -25,16 +32,16 @@ func (m *MockStorage) GetStatistics(ctx context.Context, req GetStatisticsReques
 	return []models.PeriodStats{}, nil
 }
 
-func (m *MockStorage) GetTransactionsForForecast(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
+func (m *MockStorage) GetTransactionsForForecast(ctx context.Context, req GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
 	if m.GetTransactionsForForecastFunc != nil {
-		return m.GetTransactionsForForecastFunc(ctx, userID, startDate, periods, groupBy)
+		return m.GetTransactionsForForecastFunc(ctx, req)
 	}
 	return []models.PeriodStats{}, nil
 }
 
-func (m *MockStorage) GetCategoryStatsByPeriods(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
+func (m *MockStorage) GetCategoryStatsByPeriods(ctx context.Context, req GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
 	if m.GetCategoryStatsByPeriodsFunc != nil {
-		return m.GetCategoryStatsByPeriodsFunc(ctx, userID, startDate, periods, groupBy)
+		return m.GetCategoryStatsByPeriodsFunc(ctx, req)
 	}
 	return []models.CategoryPeriodStats{}, nil
 }
@@ -45,3 +52,59 @@ func (m *MockStorage) GetRecurringPatterns(ctx context.Context, userID string) (
 	}
 	return []models.RecurringPattern{}, nil
 }
+
+func (m *MockStorage) GetRecurringIncome(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+	if m.GetRecurringIncomeFunc != nil {
+		return m.GetRecurringIncomeFunc(ctx, userID)
+	}
+	return []models.RecurringPattern{}, nil
+}
+
+func (m *MockStorage) GetCurrentBalance(ctx context.Context, userID string) (int64, error) {
+	if m.GetCurrentBalanceFunc != nil {
+		return m.GetCurrentBalanceFunc(ctx, userID)
+	}
+	return 0, nil
+}
+
+func (m *MockStorage) GetTransactions(ctx context.Context, req GetTransactionsRequest) ([]models.Transaction, error) {
+	if m.GetTransactionsFunc != nil {
+		return m.GetTransactionsFunc(ctx, req)
+	}
+	return []models.Transaction{}, nil
+}
+
+func (m *MockStorage) SaveAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+	if m.SaveAnomalyAcknowledgementFunc != nil {
+		return m.SaveAnomalyAcknowledgementFunc(ctx, ack)
+	}
+	return nil
+}
+
+func (m *MockStorage) GetAnomalyAcknowledgements(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+	if m.GetAnomalyAcknowledgementsFunc != nil {
+		return m.GetAnomalyAcknowledgementsFunc(ctx, userID)
+	}
+	return []models.AnomalyAcknowledgement{}, nil
+}
+
+func (m *MockStorage) DeleteAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+	if m.DeleteAnomalyAcknowledgementFunc != nil {
+		return m.DeleteAnomalyAcknowledgementFunc(ctx, ack)
+	}
+	return nil
+}
+
+func (m *MockStorage) SaveAnomalyThresholdOverride(ctx context.Context, override models.AnomalyThresholdOverride) error {
+	if m.SaveAnomalyThresholdOverrideFunc != nil {
+		return m.SaveAnomalyThresholdOverrideFunc(ctx, override)
+	}
+	return nil
+}
+
+func (m *MockStorage) GetAnomalyThresholdOverride(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error) {
+	if m.GetAnomalyThresholdOverrideFunc != nil {
+		return m.GetAnomalyThresholdOverrideFunc(ctx, userID)
+	}
+	return nil, nil
+}
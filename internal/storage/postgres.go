@@ -2,37 +2,41 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/cadence"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/config"
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type PostgresStorage struct {
-	pool *pgxpool.Pool
-	cfg  *config.RecurringConfig
+	pool       *pgxpool.Pool
+	cfg        *config.RecurringConfig
+	duplicates *config.DuplicateConfig
 }
 
-func NewPostgresStorage(pool *pgxpool.Pool, cfg *config.RecurringConfig) *PostgresStorage {
+func NewPostgresStorage(pool *pgxpool.Pool, cfg *config.RecurringConfig, duplicates *config.DuplicateConfig) *PostgresStorage {
 	return &PostgresStorage{
-		pool: pool,
-		cfg:  cfg,
+		pool:       pool,
+		cfg:        cfg,
+		duplicates: duplicates,
 	}
 }
 
 func (s *PostgresStorage) GetStatistics(ctx context.Context, req GetStatisticsRequest) ([]models.PeriodStats, error) {
-	truncFunc := getTruncFunction(req.GroupBy)
-
-	query := `
+	query := fmt.Sprintf(`
 		WITH user_transactions AS (
 			SELECT 
 				t.type,
 				t.amount,
 				t.currency,
 				t.mcc,
-				t.created_at
+				%s as period_start
 			FROM transactions t
 			JOIN accounts a ON t.account_id = a.id
 			WHERE a.user_id = $1
@@ -42,20 +46,20 @@ func (s *PostgresStorage) GetStatistics(ctx context.Context, req GetStatisticsRe
 		),
 		period_aggregates AS (
 			SELECT 
-				DATE_TRUNC($4, created_at) as period_start,
+				period_start,
 				type,
 				SUM(amount) as total_amount
 			FROM user_transactions
-			GROUP BY DATE_TRUNC($4, created_at), type
+			GROUP BY period_start, type
 		),
 		category_aggregates AS (
 			SELECT 
-				DATE_TRUNC($4, created_at) as period_start,
+				period_start,
 				COALESCE(mcc::TEXT, 'uncategorized') as category_id,
 				SUM(amount) as total_amount
 			FROM user_transactions
 			WHERE type = 'EXPENSE'
-			GROUP BY DATE_TRUNC($4, created_at), mcc
+			GROUP BY period_start, mcc
 		)
 		SELECT 
 			pa.period_start,
@@ -67,9 +71,9 @@ func (s *PostgresStorage) GetStatistics(ctx context.Context, req GetStatisticsRe
 		LEFT JOIN category_aggregates ca ON pa.period_start = ca.period_start
 		GROUP BY pa.period_start, ca.category_id, ca.total_amount
 		ORDER BY pa.period_start, ca.total_amount DESC NULLS LAST
-	`
+	`, req.Period.SQLBucket("t.created_at"))
 
-	rows, err := s.pool.Query(ctx, query, req.UserID, req.StartDate, req.EndDate, truncFunc)
+	rows, err := s.pool.Query(ctx, query, req.UserID, req.StartDate, req.EndDate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query statistics: %w", err)
 	}
@@ -98,7 +102,7 @@ func (s *PostgresStorage) GetStatistics(ctx context.Context, req GetStatisticsRe
 		if !exists {
 			period = &models.PeriodStats{
 				PeriodStart: periodStart,
-				PeriodEnd:   calculatePeriodEnd(periodStart, req.GroupBy),
+				PeriodEnd:   req.Period.End(periodStart),
 				Income:      income,
 				Expense:     expense,
 				Balance:     income - expense,
@@ -166,15 +170,13 @@ func (s *PostgresStorage) getCategoryBreakdown(ctx context.Context, userID strin
 	return categories, nil
 }
 
-func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error) {
-	truncFunc := getTruncFunction(groupBy)
-
-	query := `
+func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, req GetTransactionsForForecastRequest) ([]models.PeriodStats, error) {
+	query := fmt.Sprintf(`
 		WITH user_transactions AS (
 			SELECT 
 				t.type,
 				t.amount,
-				t.created_at
+				%s as period_start
 			FROM transactions t
 			JOIN accounts a ON t.account_id = a.id
 			WHERE a.user_id = $1
@@ -183,11 +185,11 @@ func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, userID
 		),
 		period_aggregates AS (
 			SELECT 
-				DATE_TRUNC($3, created_at) as period_start,
+				period_start,
 				type,
 				SUM(amount) as total_amount
 			FROM user_transactions
-			GROUP BY DATE_TRUNC($3, created_at), type
+			GROUP BY period_start, type
 		)
 		SELECT 
 			pa.period_start,
@@ -196,10 +198,10 @@ func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, userID
 		FROM period_aggregates pa
 		GROUP BY pa.period_start
 		ORDER BY pa.period_start DESC
-		LIMIT $4
-	`
+		LIMIT $3
+	`, req.Period.SQLBucket("t.created_at"))
 
-	rows, err := s.pool.Query(ctx, query, userID, startDate, truncFunc, periods)
+	rows, err := s.pool.Query(ctx, query, req.UserID, req.StartDate, req.Periods)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query forecast data: %w", err)
 	}
@@ -217,7 +219,7 @@ func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, userID
 		}
 
 		period.PeriodStart = periodStart
-		period.PeriodEnd = calculatePeriodEnd(periodStart, groupBy)
+		period.PeriodEnd = req.Period.End(periodStart)
 		period.Income = income
 		period.Expense = expense
 		period.Balance = income - expense
@@ -232,58 +234,41 @@ func (s *PostgresStorage) GetTransactionsForForecast(ctx context.Context, userID
 	return periods_data, nil
 }
 
-func getTruncFunction(period models.TimePeriod) string {
-	switch period {
-	case models.TimePeriodMonth:
-		return "month"
-	case models.TimePeriodQuarter:
-		return "quarter"
-	case models.TimePeriodYear:
-		return "year"
-	default:
-		return "month"
-	}
-}
-
-func calculatePeriodEnd(start time.Time, period models.TimePeriod) time.Time {
-	switch period {
-	case models.TimePeriodMonth:
-		return start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	case models.TimePeriodQuarter:
-		return start.AddDate(0, 3, 0).Add(-time.Nanosecond)
-	case models.TimePeriodYear:
-		return start.AddDate(1, 0, 0).Add(-time.Nanosecond)
-	default:
-		return start.AddDate(0, 1, 0).Add(-time.Nanosecond)
-	}
-}
-
-func (s *PostgresStorage) GetCategoryStatsByPeriods(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error) {
-	truncFunc := getTruncFunction(groupBy)
-
-	query := `
+func (s *PostgresStorage) GetCategoryStatsByPeriods(ctx context.Context, req GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error) {
+	query := fmt.Sprintf(`
 		WITH user_transactions AS (
 			SELECT 
 				t.mcc,
 				t.amount,
-				t.created_at
+				%s as period_start
 			FROM transactions t
 			JOIN accounts a ON t.account_id = a.id
 			WHERE a.user_id = $1
 				AND t.created_at >= $2
-				AND t.type = 'EXPENSE'
+				AND ($5::TIMESTAMPTZ IS NULL OR t.created_at < $5)
+				AND t.type = $4
 		)
 		SELECT 
-			DATE_TRUNC($3, created_at) as period_start,
+			period_start,
 			COALESCE(mcc::TEXT, 'uncategorized') as category_id,
 			SUM(amount) as total_amount
 		FROM user_transactions
-		GROUP BY DATE_TRUNC($3, created_at), mcc
+		GROUP BY period_start, mcc
 		ORDER BY period_start DESC
-		LIMIT $4 * 50
-	`
+		LIMIT $3 * 50
+	`, req.Period.SQLBucket("t.created_at"))
+
+	txType := req.Type
+	if txType == "" {
+		txType = models.TransactionTypeExpense
+	}
+
+	var endDate *time.Time
+	if !req.EndDate.IsZero() {
+		endDate = &req.EndDate
+	}
 
-	rows, err := s.pool.Query(ctx, query, userID, startDate, truncFunc, periods)
+	rows, err := s.pool.Query(ctx, query, req.UserID, req.StartDate, req.Periods, string(txType), endDate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query category stats: %w", err)
 	}
@@ -307,42 +292,134 @@ func (s *PostgresStorage) GetCategoryStatsByPeriods(ctx context.Context, userID
 }
 
 func (s *PostgresStorage) GetRecurringPatterns(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+	return s.getRecurringPatterns(ctx, userID, models.TransactionTypeExpense)
+}
+
+// GetRecurringIncome detects regular income such as salary. Income is
+// usually not categorized, so transactions without MCC form their own series.
+func (s *PostgresStorage) GetRecurringIncome(ctx context.Context, userID string) ([]models.RecurringPattern, error) {
+	return s.getRecurringPatterns(ctx, userID, models.TransactionTypeIncome)
+}
+
+const defaultRecurringAmountTolerance = 25.0
+
+// merchantExpression normalizes a description into a merchant identity:
+// lower case letters only, so order numbers and dates in "NETFLIX.COM
+// 12345" do not split a series.
+const merchantExpression = `TRIM(REGEXP_REPLACE(LOWER(COALESCE(t.description, '')), '[^[:alpha:]]+', ' ', 'g'))`
+
+func (s *PostgresStorage) getRecurringPatterns(ctx context.Context, userID string, txType models.TransactionType) ([]models.RecurringPattern, error) {
+	buckets, err := cadence.Buckets(*s.cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	lookbackMonths := s.cfg.LookbackMonths
-	minOccurrences := s.cfg.MinOccurrences
-	intervalMinDays := s.cfg.IntervalMinDays
-	intervalMaxDays := s.cfg.IntervalMaxDays
+	minOccurrences := cadence.MinOccurrences(buckets)
+
+	// Income descriptions usually carry the pay period ("salary for May"),
+	// so income is split by amount only.
+	mccFilter := "AND t.mcc IS NOT NULL"
+	merchant := merchantExpression
+	if txType == models.TransactionTypeIncome {
+		mccFilter = ""
+		merchant = "''"
+	}
 
+	// A double charge would otherwise add a near-zero interval and look like
+	// a much more frequent payment, so repeats of the previous charge on the
+	// same account within the duplicate window are dropped first.
+	var duplicates config.DuplicateConfig
+	if s.duplicates != nil {
+		duplicates = *s.duplicates
+	}
+	duplicateFilter := fmt.Sprintf(`AND NOT (
+					prev_charge_at IS NOT NULL
+					AND created_at - prev_charge_at <= INTERVAL '%d seconds'
+					AND ABS(amount - prev_charge_amount) <= GREATEST(amount, prev_charge_amount) * %f / 100
+				)`, int64(duplicates.Window().Seconds()), duplicates.Tolerance())
+
+	amountTolerance := s.cfg.AmountTolerancePercent
+	if amountTolerance <= 0 {
+		amountTolerance = defaultRecurringAmountTolerance
+	}
+
+	// Series are keyed by merchant and MCC, so two subscriptions sharing an
+	// MCC do not merge into one twice as frequent. Within a merchant, amounts
+	// sorted ascending start a new cluster at every gap above the tolerance;
+	// two transfers of different rents stay apart while gradual price rises
+	// stay in one series.
 	query := fmt.Sprintf(`
-		WITH user_expenses AS (
+		WITH account_transactions AS (
 			SELECT 
 				t.mcc,
 				t.amount,
 				t.created_at,
-				LAG(t.created_at) OVER (
-					PARTITION BY t.mcc
-					ORDER BY t.created_at
-				) as prev_date
+				COALESCE(t.description, '') as description,
+				%s as merchant,
+				LAG(t.created_at) OVER charges as prev_charge_at,
+				LAG(t.amount) OVER charges as prev_charge_amount
 			FROM transactions t
 			JOIN accounts a ON t.account_id = a.id
 			WHERE a.user_id = $1
 				AND t.created_at >= NOW() - INTERVAL '%d months'
-				AND t.type = 'EXPENSE'
-				AND t.mcc IS NOT NULL
+				AND t.type = $2
+				%s
+			WINDOW charges AS (
+				PARTITION BY t.account_id, t.mcc, REGEXP_REPLACE(LOWER(TRIM(COALESCE(t.description, ''))), '\s+', ' ', 'g')
+				ORDER BY t.created_at
+			)
+		),
+		merchant_transactions AS (
+			SELECT 
+				COALESCE(mcc::TEXT, 'uncategorized') as mcc,
+				merchant,
+				description,
+				amount,
+				created_at,
+				CASE
+					WHEN amount > LAG(amount) OVER (PARTITION BY mcc, merchant ORDER BY amount, created_at) * %f THEN 1
+					ELSE 0
+				END as new_cluster
+			FROM account_transactions
+			WHERE TRUE
+				%s
+		),
+		clustered_transactions AS (
+			SELECT 
+				*,
+				SUM(new_cluster) OVER (PARTITION BY mcc, merchant ORDER BY amount, created_at) as amount_cluster
+			FROM merchant_transactions
+		),
+		user_transactions AS (
+			SELECT 
+				mcc,
+				merchant,
+				amount_cluster,
+				description,
+				amount,
+				created_at,
+				LAG(created_at) OVER (
+					PARTITION BY mcc, merchant, amount_cluster
+					ORDER BY created_at
+				) as prev_date
+			FROM clustered_transactions
 		)
 		SELECT 
 			mcc,
+			(ARRAY_AGG(description ORDER BY created_at DESC))[1] as merchant_name,
 			PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY amount)::BIGINT as median_amount,
 			AVG(EXTRACT(EPOCH FROM (created_at - prev_date))/86400) as avg_interval_days,
+			COUNT(*) as occurrences,
 			MAX(created_at) as last_occurrence
-		FROM user_expenses
+		FROM user_transactions
 		WHERE prev_date IS NOT NULL
-		GROUP BY mcc
+		GROUP BY mcc, merchant, amount_cluster
 		HAVING COUNT(*) >= %d
-			AND AVG(EXTRACT(EPOCH FROM (created_at - prev_date))/86400) BETWEEN %d AND %d
 		ORDER BY last_occurrence DESC
-	`, lookbackMonths, minOccurrences, intervalMinDays, intervalMaxDays)
+	`, merchant, lookbackMonths, mccFilter, 1+amountTolerance/100, duplicateFilter, minOccurrences)
 
-	rows, err := s.pool.Query(ctx, query, userID)
+	rows, err := s.pool.Query(ctx, query, userID, string(txType))
 	if err != nil {
 		return nil, fmt.Errorf("failed to query recurring patterns: %w", err)
 	}
@@ -352,9 +429,18 @@ func (s *PostgresStorage) GetRecurringPatterns(ctx context.Context, userID strin
 
 	for rows.Next() {
 		var pattern models.RecurringPattern
-		if err := rows.Scan(&pattern.MCC, &pattern.MedianAmount, &pattern.AvgIntervalDays, &pattern.LastOccurrence); err != nil {
+		if err := rows.Scan(&pattern.MCC, &pattern.Merchant, &pattern.MedianAmount, &pattern.AvgIntervalDays, &pattern.Occurrences, &pattern.LastOccurrence); err != nil {
 			return nil, fmt.Errorf("failed to scan recurring pattern: %w", err)
 		}
+
+		// Series that fit no cadence, such as irregular grocery runs, are
+		// not recurring.
+		c, ok := cadence.Classify(buckets, pattern.AvgIntervalDays, pattern.Occurrences)
+		if !ok {
+			continue
+		}
+		pattern.Cadence = c
+		pattern.Merchant = strings.TrimSpace(pattern.Merchant)
 		patterns = append(patterns, pattern)
 	}
 
@@ -364,3 +450,197 @@ func (s *PostgresStorage) GetRecurringPatterns(ctx context.Context, userID strin
 
 	return patterns, nil
 }
+
+// GetCurrentBalance derives the balance across all of the user's accounts
+// from the transaction ledger.
+func (s *PostgresStorage) GetCurrentBalance(ctx context.Context, userID string) (int64, error) {
+	query := `
+		SELECT 
+			COALESCE(SUM(CASE WHEN t.type = 'INCOME' THEN t.amount ELSE -t.amount END), 0)::BIGINT as balance
+		FROM transactions t
+		JOIN accounts a ON t.account_id = a.id
+		WHERE a.user_id = $1
+			AND t.type IN ('INCOME', 'EXPENSE')
+	`
+
+	var balance int64
+	if err := s.pool.QueryRow(ctx, query, userID).Scan(&balance); err != nil {
+		return 0, fmt.Errorf("failed to query current balance: %w", err)
+	}
+
+	return balance, nil
+}
+
+// GetTransactions returns the user's raw transactions in chronological order.
+func (s *PostgresStorage) GetTransactions(ctx context.Context, req GetTransactionsRequest) ([]models.Transaction, error) {
+	query := `
+		SELECT 
+			t.id::TEXT,
+			t.account_id::TEXT,
+			a.user_id::TEXT,
+			t.type,
+			t.amount,
+			t.currency,
+			t.mcc::INT,
+			COALESCE(t.description, ''),
+			t.created_at
+		FROM transactions t
+		JOIN accounts a ON t.account_id = a.id
+		WHERE a.user_id = $1
+			AND t.created_at >= $2
+			AND t.created_at < $3
+			AND ($4 = '' OR t.type = $4)
+		ORDER BY t.created_at
+	`
+
+	rows, err := s.pool.Query(ctx, query, req.UserID, req.StartDate, req.EndDate, string(req.Type))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []models.Transaction
+
+	for rows.Next() {
+		var tx models.Transaction
+		var txType string
+		if err := rows.Scan(&tx.ID, &tx.AccountID, &tx.UserID, &txType, &tx.Amount, &tx.Currency, &tx.MCC, &tx.Description, &tx.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan transaction: %w", err)
+		}
+		tx.Type = models.TransactionType(txType)
+		transactions = append(transactions, tx)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating transactions: %w", err)
+	}
+
+	return transactions, nil
+}
+
+// SaveAnomalyAcknowledgement stores the user's latest reaction per category,
+// flow and period; a zero PeriodStart is stored as NULL and covers every
+// period.
+func (s *PostgresStorage) SaveAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+	query := `
+		INSERT INTO analyzer_anomaly_acknowledgements (user_id, mcc, flow_type, period_start, action)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, mcc, flow_type, (COALESCE(period_start, 'epoch'::TIMESTAMPTZ)))
+		DO UPDATE SET action = EXCLUDED.action, created_at = NOW()
+	`
+
+	var periodStart *time.Time
+	if !ack.PeriodStart.IsZero() {
+		periodStart = &ack.PeriodStart
+	}
+
+	if _, err := s.pool.Exec(ctx, query, ack.UserID, ack.MCC, string(ack.FlowType), periodStart, string(ack.Action)); err != nil {
+		return fmt.Errorf("failed to save anomaly acknowledgement: %w", err)
+	}
+
+	return nil
+}
+
+func (s *PostgresStorage) GetAnomalyAcknowledgements(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error) {
+	query := `
+		SELECT mcc, flow_type, period_start, action, created_at
+		FROM analyzer_anomaly_acknowledgements
+		WHERE user_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query anomaly acknowledgements: %w", err)
+	}
+	defer rows.Close()
+
+	var acks []models.AnomalyAcknowledgement
+
+	for rows.Next() {
+		ack := models.AnomalyAcknowledgement{UserID: userID}
+		var flowType, action string
+		var periodStart *time.Time
+		if err := rows.Scan(&ack.MCC, &flowType, &periodStart, &action, &ack.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan anomaly acknowledgement: %w", err)
+		}
+		ack.FlowType = models.TransactionType(flowType)
+		ack.Action = models.AnomalyAction(action)
+		if periodStart != nil {
+			ack.PeriodStart = *periodStart
+		}
+		acks = append(acks, ack)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating anomaly acknowledgements: %w", err)
+	}
+
+	return acks, nil
+}
+
+// DeleteAnomalyAcknowledgement removes the acknowledgement stored for the
+// category, flow and period only if its action is still ack.Action, so lifting
+// a suppression does not drop an acknowledgement saved over it.
+func (s *PostgresStorage) DeleteAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error {
+	query := `
+		DELETE FROM analyzer_anomaly_acknowledgements
+		WHERE user_id = $1
+			AND mcc = $2
+			AND flow_type = $3
+			AND COALESCE(period_start, 'epoch'::TIMESTAMPTZ) = COALESCE($4::TIMESTAMPTZ, 'epoch'::TIMESTAMPTZ)
+			AND action = $5
+	`
+
+	var periodStart *time.Time
+	if !ack.PeriodStart.IsZero() {
+		periodStart = &ack.PeriodStart
+	}
+
+	if _, err := s.pool.Exec(ctx, query, ack.UserID, ack.MCC, string(ack.FlowType), periodStart, string(ack.Action)); err != nil {
+		return fmt.Errorf("failed to delete anomaly acknowledgement: %w", err)
+	}
+
+	return nil
+}
+
+// SaveAnomalyThresholdOverride replaces the user's thresholds; a nil field
+// is stored as NULL and clears that override.
+func (s *PostgresStorage) SaveAnomalyThresholdOverride(ctx context.Context, override models.AnomalyThresholdOverride) error {
+	query := `
+		INSERT INTO analyzer_anomaly_thresholds (user_id, deviation_threshold, new_category_threshold)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id)
+		DO UPDATE SET
+			deviation_threshold = EXCLUDED.deviation_threshold,
+			new_category_threshold = EXCLUDED.new_category_threshold,
+			updated_at = NOW()
+	`
+
+	if _, err := s.pool.Exec(ctx, query, override.UserID, override.DeviationThreshold, override.NewCategoryThreshold); err != nil {
+		return fmt.Errorf("failed to save anomaly thresholds: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnomalyThresholdOverride returns nil when the user has not set any
+// thresholds.
+func (s *PostgresStorage) GetAnomalyThresholdOverride(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error) {
+	query := `
+		SELECT deviation_threshold, new_category_threshold, updated_at
+		FROM analyzer_anomaly_thresholds
+		WHERE user_id = $1
+	`
+
+	override := &models.AnomalyThresholdOverride{UserID: userID}
+	err := s.pool.QueryRow(ctx, query, userID).Scan(&override.DeviationThreshold, &override.NewCategoryThreshold, &override.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query anomaly thresholds: %w", err)
+	}
+
+	return override, nil
+}
@@ -5,18 +5,55 @@ import (
 	"time"
 
 	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/models"
+	"github.com/Finance-Tracker-MHS-DevDays-Fall-2025/analyzer/internal/period"
 )
 
 type TransactionStorage interface {
 	GetStatistics(ctx context.Context, req GetStatisticsRequest) ([]models.PeriodStats, error)
-	GetTransactionsForForecast(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.PeriodStats, error)
-	GetCategoryStatsByPeriods(ctx context.Context, userID string, startDate time.Time, periods int, groupBy models.TimePeriod) ([]models.CategoryPeriodStats, error)
+	GetTransactionsForForecast(ctx context.Context, req GetTransactionsForForecastRequest) ([]models.PeriodStats, error)
+	GetCategoryStatsByPeriods(ctx context.Context, req GetCategoryStatsByPeriodsRequest) ([]models.CategoryPeriodStats, error)
 	GetRecurringPatterns(ctx context.Context, userID string) ([]models.RecurringPattern, error)
+	GetRecurringIncome(ctx context.Context, userID string) ([]models.RecurringPattern, error)
+	GetCurrentBalance(ctx context.Context, userID string) (int64, error)
+	GetTransactions(ctx context.Context, req GetTransactionsRequest) ([]models.Transaction, error)
+	SaveAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error
+	GetAnomalyAcknowledgements(ctx context.Context, userID string) ([]models.AnomalyAcknowledgement, error)
+	DeleteAnomalyAcknowledgement(ctx context.Context, ack models.AnomalyAcknowledgement) error
+	SaveAnomalyThresholdOverride(ctx context.Context, override models.AnomalyThresholdOverride) error
+	GetAnomalyThresholdOverride(ctx context.Context, userID string) (*models.AnomalyThresholdOverride, error)
 }
 
 type GetStatisticsRequest struct {
 	UserID    string
 	StartDate time.Time
 	EndDate   time.Time
-	GroupBy   models.TimePeriod
+	Period    period.Period
+}
+
+type GetTransactionsForForecastRequest struct {
+	UserID    string
+	StartDate time.Time
+	Periods   int
+	Period    period.Period
+}
+
+// GetCategoryStatsByPeriodsRequest sums transactions of one Type per
+// period and MCC created in [StartDate, EndDate). An empty Type means
+// EXPENSE; a zero EndDate leaves the range open.
+type GetCategoryStatsByPeriodsRequest struct {
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	Periods   int
+	Period    period.Period
+	Type      models.TransactionType
+}
+
+// GetTransactionsRequest selects raw transactions created in
+// [StartDate, EndDate). An empty Type returns every type.
+type GetTransactionsRequest struct {
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	Type      models.TransactionType
 }
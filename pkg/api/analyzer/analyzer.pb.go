@@ -23,6 +23,272 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ThresholdSource int32
+
+const (
+	ThresholdSource_THRESHOLD_SOURCE_UNSPECIFIED ThresholdSource = 0
+	ThresholdSource_THRESHOLD_SOURCE_CONFIG      ThresholdSource = 1
+	ThresholdSource_THRESHOLD_SOURCE_ADAPTIVE    ThresholdSource = 2
+	ThresholdSource_THRESHOLD_SOURCE_USER        ThresholdSource = 3
+)
+
+// Enum value maps for ThresholdSource.
+var (
+	ThresholdSource_name = map[int32]string{
+		0: "THRESHOLD_SOURCE_UNSPECIFIED",
+		1: "THRESHOLD_SOURCE_CONFIG",
+		2: "THRESHOLD_SOURCE_ADAPTIVE",
+		3: "THRESHOLD_SOURCE_USER",
+	}
+	ThresholdSource_value = map[string]int32{
+		"THRESHOLD_SOURCE_UNSPECIFIED": 0,
+		"THRESHOLD_SOURCE_CONFIG":      1,
+		"THRESHOLD_SOURCE_ADAPTIVE":    2,
+		"THRESHOLD_SOURCE_USER":        3,
+	}
+)
+
+func (x ThresholdSource) Enum() *ThresholdSource {
+	p := new(ThresholdSource)
+	*p = x
+	return p
+}
+
+func (x ThresholdSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ThresholdSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_analyzer_analyzer_proto_enumTypes[0].Descriptor()
+}
+
+func (ThresholdSource) Type() protoreflect.EnumType {
+	return &file_analyzer_analyzer_proto_enumTypes[0]
+}
+
+func (x ThresholdSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ThresholdSource.Descriptor instead.
+func (ThresholdSource) EnumDescriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{0}
+}
+
+type AnomalyDirection int32
+
+const (
+	AnomalyDirection_ANOMALY_DIRECTION_UNSPECIFIED AnomalyDirection = 0
+	AnomalyDirection_ANOMALY_DIRECTION_ABOVE       AnomalyDirection = 1
+	AnomalyDirection_ANOMALY_DIRECTION_BELOW       AnomalyDirection = 2
+)
+
+// Enum value maps for AnomalyDirection.
+var (
+	AnomalyDirection_name = map[int32]string{
+		0: "ANOMALY_DIRECTION_UNSPECIFIED",
+		1: "ANOMALY_DIRECTION_ABOVE",
+		2: "ANOMALY_DIRECTION_BELOW",
+	}
+	AnomalyDirection_value = map[string]int32{
+		"ANOMALY_DIRECTION_UNSPECIFIED": 0,
+		"ANOMALY_DIRECTION_ABOVE":       1,
+		"ANOMALY_DIRECTION_BELOW":       2,
+	}
+)
+
+func (x AnomalyDirection) Enum() *AnomalyDirection {
+	p := new(AnomalyDirection)
+	*p = x
+	return p
+}
+
+func (x AnomalyDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalyDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_analyzer_analyzer_proto_enumTypes[1].Descriptor()
+}
+
+func (AnomalyDirection) Type() protoreflect.EnumType {
+	return &file_analyzer_analyzer_proto_enumTypes[1]
+}
+
+func (x AnomalyDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalyDirection.Descriptor instead.
+func (AnomalyDirection) EnumDescriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{1}
+}
+
+type AnomalySeverity int32
+
+const (
+	AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED AnomalySeverity = 0
+	AnomalySeverity_ANOMALY_SEVERITY_LOW         AnomalySeverity = 1
+	AnomalySeverity_ANOMALY_SEVERITY_MEDIUM      AnomalySeverity = 2
+	AnomalySeverity_ANOMALY_SEVERITY_HIGH        AnomalySeverity = 3
+)
+
+// Enum value maps for AnomalySeverity.
+var (
+	AnomalySeverity_name = map[int32]string{
+		0: "ANOMALY_SEVERITY_UNSPECIFIED",
+		1: "ANOMALY_SEVERITY_LOW",
+		2: "ANOMALY_SEVERITY_MEDIUM",
+		3: "ANOMALY_SEVERITY_HIGH",
+	}
+	AnomalySeverity_value = map[string]int32{
+		"ANOMALY_SEVERITY_UNSPECIFIED": 0,
+		"ANOMALY_SEVERITY_LOW":         1,
+		"ANOMALY_SEVERITY_MEDIUM":      2,
+		"ANOMALY_SEVERITY_HIGH":        3,
+	}
+)
+
+func (x AnomalySeverity) Enum() *AnomalySeverity {
+	p := new(AnomalySeverity)
+	*p = x
+	return p
+}
+
+func (x AnomalySeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnomalySeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_analyzer_analyzer_proto_enumTypes[2].Descriptor()
+}
+
+func (AnomalySeverity) Type() protoreflect.EnumType {
+	return &file_analyzer_analyzer_proto_enumTypes[2]
+}
+
+func (x AnomalySeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnomalySeverity.Descriptor instead.
+func (AnomalySeverity) EnumDescriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{2}
+}
+
+type TransactionAnomalyReason int32
+
+const (
+	TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_UNSPECIFIED         TransactionAnomalyReason = 0
+	TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE   TransactionAnomalyReason = 1
+	TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR        TransactionAnomalyReason = 2
+	TransactionAnomalyReason_TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT TransactionAnomalyReason = 3
+)
+
+// Enum value maps for TransactionAnomalyReason.
+var (
+	TransactionAnomalyReason_name = map[int32]string{
+		0: "TRANSACTION_ANOMALY_REASON_UNSPECIFIED",
+		1: "TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE",
+		2: "TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR",
+		3: "TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT",
+	}
+	TransactionAnomalyReason_value = map[string]int32{
+		"TRANSACTION_ANOMALY_REASON_UNSPECIFIED":         0,
+		"TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE":   1,
+		"TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR":        2,
+		"TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT": 3,
+	}
+)
+
+func (x TransactionAnomalyReason) Enum() *TransactionAnomalyReason {
+	p := new(TransactionAnomalyReason)
+	*p = x
+	return p
+}
+
+func (x TransactionAnomalyReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionAnomalyReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_analyzer_analyzer_proto_enumTypes[3].Descriptor()
+}
+
+func (TransactionAnomalyReason) Type() protoreflect.EnumType {
+	return &file_analyzer_analyzer_proto_enumTypes[3]
+}
+
+func (x TransactionAnomalyReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionAnomalyReason.Descriptor instead.
+func (TransactionAnomalyReason) EnumDescriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{3}
+}
+
+type RecurringCadence int32
+
+const (
+	RecurringCadence_RECURRING_CADENCE_UNSPECIFIED RecurringCadence = 0
+	RecurringCadence_RECURRING_CADENCE_WEEKLY      RecurringCadence = 1
+	RecurringCadence_RECURRING_CADENCE_BIWEEKLY    RecurringCadence = 2
+	RecurringCadence_RECURRING_CADENCE_MONTHLY     RecurringCadence = 3
+	RecurringCadence_RECURRING_CADENCE_QUARTERLY   RecurringCadence = 4
+	RecurringCadence_RECURRING_CADENCE_SEMIANNUAL  RecurringCadence = 5
+	RecurringCadence_RECURRING_CADENCE_ANNUAL      RecurringCadence = 6
+)
+
+// Enum value maps for RecurringCadence.
+var (
+	RecurringCadence_name = map[int32]string{
+		0: "RECURRING_CADENCE_UNSPECIFIED",
+		1: "RECURRING_CADENCE_WEEKLY",
+		2: "RECURRING_CADENCE_BIWEEKLY",
+		3: "RECURRING_CADENCE_MONTHLY",
+		4: "RECURRING_CADENCE_QUARTERLY",
+		5: "RECURRING_CADENCE_SEMIANNUAL",
+		6: "RECURRING_CADENCE_ANNUAL",
+	}
+	RecurringCadence_value = map[string]int32{
+		"RECURRING_CADENCE_UNSPECIFIED": 0,
+		"RECURRING_CADENCE_WEEKLY":      1,
+		"RECURRING_CADENCE_BIWEEKLY":    2,
+		"RECURRING_CADENCE_MONTHLY":     3,
+		"RECURRING_CADENCE_QUARTERLY":   4,
+		"RECURRING_CADENCE_SEMIANNUAL":  5,
+		"RECURRING_CADENCE_ANNUAL":      6,
+	}
+)
+
+func (x RecurringCadence) Enum() *RecurringCadence {
+	p := new(RecurringCadence)
+	*p = x
+	return p
+}
+
+func (x RecurringCadence) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurringCadence) Descriptor() protoreflect.EnumDescriptor {
+	return file_analyzer_analyzer_proto_enumTypes[4].Descriptor()
+}
+
+func (RecurringCadence) Type() protoreflect.EnumType {
+	return &file_analyzer_analyzer_proto_enumTypes[4]
+}
+
+func (x RecurringCadence) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurringCadence.Descriptor instead.
+func (RecurringCadence) EnumDescriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{4}
+}
+
 type PeriodBalance struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	PeriodStart       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
@@ -160,15 +426,18 @@ func (x *CategorySpending) GetTotalAmount() *common.Money {
 }
 
 type Forecast struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	PeriodStart       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
-	PeriodEnd         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
-	ExpectedIncome    *common.Money          `protobuf:"bytes,3,opt,name=expected_income,json=expectedIncome,proto3" json:"expected_income,omitempty"`
-	ExpectedExpense   *common.Money          `protobuf:"bytes,4,opt,name=expected_expense,json=expectedExpense,proto3" json:"expected_expense,omitempty"`
-	ExpectedBalance   *common.Money          `protobuf:"bytes,5,opt,name=expected_balance,json=expectedBalance,proto3" json:"expected_balance,omitempty"`
-	CategoryBreakdown []*CategorySpending    `protobuf:"bytes,6,rep,name=category_breakdown,json=categoryBreakdown,proto3" json:"category_breakdown,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	ExpectedIncome       *common.Money          `protobuf:"bytes,3,opt,name=expected_income,json=expectedIncome,proto3" json:"expected_income,omitempty"`
+	ExpectedExpense      *common.Money          `protobuf:"bytes,4,opt,name=expected_expense,json=expectedExpense,proto3" json:"expected_expense,omitempty"`
+	ExpectedBalance      *common.Money          `protobuf:"bytes,5,opt,name=expected_balance,json=expectedBalance,proto3" json:"expected_balance,omitempty"`
+	CategoryBreakdown    []*CategorySpending    `protobuf:"bytes,6,rep,name=category_breakdown,json=categoryBreakdown,proto3" json:"category_breakdown,omitempty"`
+	Intervals            []*ForecastInterval    `protobuf:"bytes,7,rep,name=intervals,proto3" json:"intervals,omitempty"`
+	CommittedExpense     *common.Money          `protobuf:"bytes,8,opt,name=committed_expense,json=committedExpense,proto3" json:"committed_expense,omitempty"`
+	DiscretionaryExpense *common.Money          `protobuf:"bytes,9,opt,name=discretionary_expense,json=discretionaryExpense,proto3" json:"discretionary_expense,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *Forecast) Reset() {
@@ -243,19 +512,133 @@ func (x *Forecast) GetCategoryBreakdown() []*CategorySpending {
 	return nil
 }
 
+func (x *Forecast) GetIntervals() []*ForecastInterval {
+	if x != nil {
+		return x.Intervals
+	}
+	return nil
+}
+
+func (x *Forecast) GetCommittedExpense() *common.Money {
+	if x != nil {
+		return x.CommittedExpense
+	}
+	return nil
+}
+
+func (x *Forecast) GetDiscretionaryExpense() *common.Money {
+	if x != nil {
+		return x.DiscretionaryExpense
+	}
+	return nil
+}
+
+type ForecastInterval struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         float64                `protobuf:"fixed64,1,opt,name=level,proto3" json:"level,omitempty"`
+	IncomeLower   *common.Money          `protobuf:"bytes,2,opt,name=income_lower,json=incomeLower,proto3" json:"income_lower,omitempty"`
+	IncomeUpper   *common.Money          `protobuf:"bytes,3,opt,name=income_upper,json=incomeUpper,proto3" json:"income_upper,omitempty"`
+	ExpenseLower  *common.Money          `protobuf:"bytes,4,opt,name=expense_lower,json=expenseLower,proto3" json:"expense_lower,omitempty"`
+	ExpenseUpper  *common.Money          `protobuf:"bytes,5,opt,name=expense_upper,json=expenseUpper,proto3" json:"expense_upper,omitempty"`
+	BalanceLower  *common.Money          `protobuf:"bytes,6,opt,name=balance_lower,json=balanceLower,proto3" json:"balance_lower,omitempty"`
+	BalanceUpper  *common.Money          `protobuf:"bytes,7,opt,name=balance_upper,json=balanceUpper,proto3" json:"balance_upper,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastInterval) Reset() {
+	*x = ForecastInterval{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastInterval) ProtoMessage() {}
+
+func (x *ForecastInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastInterval.ProtoReflect.Descriptor instead.
+func (*ForecastInterval) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForecastInterval) GetLevel() float64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ForecastInterval) GetIncomeLower() *common.Money {
+	if x != nil {
+		return x.IncomeLower
+	}
+	return nil
+}
+
+func (x *ForecastInterval) GetIncomeUpper() *common.Money {
+	if x != nil {
+		return x.IncomeUpper
+	}
+	return nil
+}
+
+func (x *ForecastInterval) GetExpenseLower() *common.Money {
+	if x != nil {
+		return x.ExpenseLower
+	}
+	return nil
+}
+
+func (x *ForecastInterval) GetExpenseUpper() *common.Money {
+	if x != nil {
+		return x.ExpenseUpper
+	}
+	return nil
+}
+
+func (x *ForecastInterval) GetBalanceLower() *common.Money {
+	if x != nil {
+		return x.BalanceLower
+	}
+	return nil
+}
+
+func (x *ForecastInterval) GetBalanceUpper() *common.Money {
+	if x != nil {
+		return x.BalanceUpper
+	}
+	return nil
+}
+
 type GetStatisticsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	GroupBy       common.TimePeriod      `protobuf:"varint,4,opt,name=group_by,json=groupBy,proto3,enum=common.TimePeriod" json:"group_by,omitempty"`
+	FillGaps      bool                   `protobuf:"varint,6,opt,name=fill_gaps,json=fillGaps,proto3" json:"fill_gaps,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetStatisticsRequest) Reset() {
 	*x = GetStatisticsRequest{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[3]
+	mi := &file_analyzer_analyzer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +650,7 @@ func (x *GetStatisticsRequest) String() string {
 func (*GetStatisticsRequest) ProtoMessage() {}
 
 func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[3]
+	mi := &file_analyzer_analyzer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +663,7 @@ func (x *GetStatisticsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatisticsRequest.ProtoReflect.Descriptor instead.
 func (*GetStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{3}
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetStatisticsRequest) GetUserId() string {
@@ -311,6 +694,13 @@ func (x *GetStatisticsRequest) GetGroupBy() common.TimePeriod {
 	return common.TimePeriod(0)
 }
 
+func (x *GetStatisticsRequest) GetFillGaps() bool {
+	if x != nil {
+		return x.FillGaps
+	}
+	return false
+}
+
 type GetStatisticsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TotalIncome   *common.Money          `protobuf:"bytes,1,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
@@ -322,7 +712,7 @@ type GetStatisticsResponse struct {
 
 func (x *GetStatisticsResponse) Reset() {
 	*x = GetStatisticsResponse{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[4]
+	mi := &file_analyzer_analyzer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -334,7 +724,7 @@ func (x *GetStatisticsResponse) String() string {
 func (*GetStatisticsResponse) ProtoMessage() {}
 
 func (x *GetStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[4]
+	mi := &file_analyzer_analyzer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +737,7 @@ func (x *GetStatisticsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatisticsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{4}
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetStatisticsResponse) GetTotalIncome() *common.Money {
@@ -376,13 +766,14 @@ type GetForecastRequest struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
 	PeriodsAhead  int32                  `protobuf:"varint,3,opt,name=periods_ahead,json=periodsAhead,proto3" json:"periods_ahead,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetForecastRequest) Reset() {
 	*x = GetForecastRequest{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[5]
+	mi := &file_analyzer_analyzer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +785,7 @@ func (x *GetForecastRequest) String() string {
 func (*GetForecastRequest) ProtoMessage() {}
 
 func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[5]
+	mi := &file_analyzer_analyzer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +798,7 @@ func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
 func (*GetForecastRequest) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{5}
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetForecastRequest) GetUserId() string {
@@ -431,16 +822,26 @@ func (x *GetForecastRequest) GetPeriodsAhead() int32 {
 	return 0
 }
 
+func (x *GetForecastRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
 type GetForecastResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Forecasts     []*Forecast            `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	IncomeTrend   *ForecastTrend         `protobuf:"bytes,3,opt,name=income_trend,json=incomeTrend,proto3" json:"income_trend,omitempty"`
+	ExpenseTrend  *ForecastTrend         `protobuf:"bytes,4,opt,name=expense_trend,json=expenseTrend,proto3" json:"expense_trend,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetForecastResponse) Reset() {
 	*x = GetForecastResponse{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[6]
+	mi := &file_analyzer_analyzer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -452,7 +853,7 @@ func (x *GetForecastResponse) String() string {
 func (*GetForecastResponse) ProtoMessage() {}
 
 func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[6]
+	mi := &file_analyzer_analyzer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,7 +866,7 @@ func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
 func (*GetForecastResponse) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{6}
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetForecastResponse) GetForecasts() []*Forecast {
@@ -475,29 +876,50 @@ func (x *GetForecastResponse) GetForecasts() []*Forecast {
 	return nil
 }
 
-type GetAnomaliesRequest struct {
+func (x *GetForecastResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetForecastResponse) GetIncomeTrend() *ForecastTrend {
+	if x != nil {
+		return x.IncomeTrend
+	}
+	return nil
+}
+
+func (x *GetForecastResponse) GetExpenseTrend() *ForecastTrend {
+	if x != nil {
+		return x.ExpenseTrend
+	}
+	return nil
+}
+
+type ForecastTrend struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	Slope         float64                `protobuf:"fixed64,1,opt,name=slope,proto3" json:"slope,omitempty"`
+	SlopePercent  float64                `protobuf:"fixed64,2,opt,name=slope_percent,json=slopePercent,proto3" json:"slope_percent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnomaliesRequest) Reset() {
-	*x = GetAnomaliesRequest{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[7]
+func (x *ForecastTrend) Reset() {
+	*x = ForecastTrend{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnomaliesRequest) String() string {
+func (x *ForecastTrend) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnomaliesRequest) ProtoMessage() {}
+func (*ForecastTrend) ProtoMessage() {}
 
-func (x *GetAnomaliesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[7]
+func (x *ForecastTrend) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,47 +930,1740 @@ func (x *GetAnomaliesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnomaliesRequest.ProtoReflect.Descriptor instead.
-func (*GetAnomaliesRequest) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ForecastTrend.ProtoReflect.Descriptor instead.
+func (*ForecastTrend) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ForecastTrend) GetSlope() float64 {
+	if x != nil {
+		return x.Slope
+	}
+	return 0
+}
+
+func (x *ForecastTrend) GetSlopePercent() float64 {
+	if x != nil {
+		return x.SlopePercent
+	}
+	return 0
+}
+
+type GetAnomaliesRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	// Any moment within the period to analyze; unset analyzes the latest
+	// period with data.
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesRequest) Reset() {
+	*x = GetAnomaliesRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesRequest) ProtoMessage() {}
+
+func (x *GetAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetAnomaliesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAnomaliesRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+func (x *GetAnomaliesRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+type GetAnomaliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*CategoryAnomaly     `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Thresholds    *AnomalyThresholds     `protobuf:"bytes,2,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAnomaliesResponse) Reset() {
+	*x = GetAnomaliesResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnomaliesResponse) ProtoMessage() {}
+
+func (x *GetAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*GetAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetAnomaliesResponse) GetAnomalies() []*CategoryAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *GetAnomaliesResponse) GetThresholds() *AnomalyThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+// Thresholds applied to the user, before the per-category raise from
+// acknowledgements.
+type AnomalyThresholds struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DeviationThreshold   float64                `protobuf:"fixed64,1,opt,name=deviation_threshold,json=deviationThreshold,proto3" json:"deviation_threshold,omitempty"`
+	DeviationSource      ThresholdSource        `protobuf:"varint,2,opt,name=deviation_source,json=deviationSource,proto3,enum=analyzer.ThresholdSource" json:"deviation_source,omitempty"`
+	NewCategoryThreshold *common.Money          `protobuf:"bytes,3,opt,name=new_category_threshold,json=newCategoryThreshold,proto3" json:"new_category_threshold,omitempty"`
+	NewCategorySource    ThresholdSource        `protobuf:"varint,4,opt,name=new_category_source,json=newCategorySource,proto3,enum=analyzer.ThresholdSource" json:"new_category_source,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AnomalyThresholds) Reset() {
+	*x = AnomalyThresholds{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyThresholds) ProtoMessage() {}
+
+func (x *AnomalyThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyThresholds.ProtoReflect.Descriptor instead.
+func (*AnomalyThresholds) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AnomalyThresholds) GetDeviationThreshold() float64 {
+	if x != nil {
+		return x.DeviationThreshold
+	}
+	return 0
+}
+
+func (x *AnomalyThresholds) GetDeviationSource() ThresholdSource {
+	if x != nil {
+		return x.DeviationSource
+	}
+	return ThresholdSource_THRESHOLD_SOURCE_UNSPECIFIED
+}
+
+func (x *AnomalyThresholds) GetNewCategoryThreshold() *common.Money {
+	if x != nil {
+		return x.NewCategoryThreshold
+	}
+	return nil
+}
+
+func (x *AnomalyThresholds) GetNewCategorySource() ThresholdSource {
+	if x != nil {
+		return x.NewCategorySource
+	}
+	return ThresholdSource_THRESHOLD_SOURCE_UNSPECIFIED
+}
+
+// Unset thresholds clear the user's override for them.
+type SetAnomalyThresholdsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	UserId               string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviationThreshold   *float64               `protobuf:"fixed64,2,opt,name=deviation_threshold,json=deviationThreshold,proto3,oneof" json:"deviation_threshold,omitempty"`
+	NewCategoryThreshold *common.Money          `protobuf:"bytes,3,opt,name=new_category_threshold,json=newCategoryThreshold,proto3" json:"new_category_threshold,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetAnomalyThresholdsRequest) Reset() {
+	*x = SetAnomalyThresholdsRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnomalyThresholdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnomalyThresholdsRequest) ProtoMessage() {}
+
+func (x *SetAnomalyThresholdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnomalyThresholdsRequest.ProtoReflect.Descriptor instead.
+func (*SetAnomalyThresholdsRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetAnomalyThresholdsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetAnomalyThresholdsRequest) GetDeviationThreshold() float64 {
+	if x != nil && x.DeviationThreshold != nil {
+		return *x.DeviationThreshold
+	}
+	return 0
+}
+
+func (x *SetAnomalyThresholdsRequest) GetNewCategoryThreshold() *common.Money {
+	if x != nil {
+		return x.NewCategoryThreshold
+	}
+	return nil
+}
+
+type SetAnomalyThresholdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAnomalyThresholdsResponse) Reset() {
+	*x = SetAnomalyThresholdsResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAnomalyThresholdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAnomalyThresholdsResponse) ProtoMessage() {}
+
+func (x *SetAnomalyThresholdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAnomalyThresholdsResponse.ProtoReflect.Descriptor instead.
+func (*SetAnomalyThresholdsResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{13}
+}
+
+type CategoryAnomaly struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Mcc             string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	ActualAmount    *common.Money          `protobuf:"bytes,2,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`
+	ExpectedAmount  *common.Money          `protobuf:"bytes,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	DeviationAmount *common.Money          `protobuf:"bytes,4,opt,name=deviation_amount,json=deviationAmount,proto3" json:"deviation_amount,omitempty"`
+	Score           float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	Severity        AnomalySeverity        `protobuf:"varint,6,opt,name=severity,proto3,enum=analyzer.AnomalySeverity" json:"severity,omitempty"`
+	Direction       AnomalyDirection       `protobuf:"varint,7,opt,name=direction,proto3,enum=analyzer.AnomalyDirection" json:"direction,omitempty"`
+	FlowType        common.TransactionType `protobuf:"varint,8,opt,name=flow_type,json=flowType,proto3,enum=common.TransactionType" json:"flow_type,omitempty"`
+	Baseline        []*BaselinePeriod      `protobuf:"bytes,9,rep,name=baseline,proto3" json:"baseline,omitempty"`
+	TopTransactions []*AnomalyTransaction  `protobuf:"bytes,10,rep,name=top_transactions,json=topTransactions,proto3" json:"top_transactions,omitempty"`
+	PeriodStart     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Acknowledged    bool                   `protobuf:"varint,12,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CategoryAnomaly) Reset() {
+	*x = CategoryAnomaly{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryAnomaly) ProtoMessage() {}
+
+func (x *CategoryAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryAnomaly.ProtoReflect.Descriptor instead.
+func (*CategoryAnomaly) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CategoryAnomaly) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *CategoryAnomaly) GetActualAmount() *common.Money {
+	if x != nil {
+		return x.ActualAmount
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetExpectedAmount() *common.Money {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetDeviationAmount() *common.Money {
+	if x != nil {
+		return x.DeviationAmount
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CategoryAnomaly) GetSeverity() AnomalySeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return AnomalySeverity_ANOMALY_SEVERITY_UNSPECIFIED
+}
+
+func (x *CategoryAnomaly) GetDirection() AnomalyDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return AnomalyDirection_ANOMALY_DIRECTION_UNSPECIFIED
+}
+
+func (x *CategoryAnomaly) GetFlowType() common.TransactionType {
+	if x != nil {
+		return x.FlowType
+	}
+	return common.TransactionType(0)
+}
+
+func (x *CategoryAnomaly) GetBaseline() []*BaselinePeriod {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetTopTransactions() []*AnomalyTransaction {
+	if x != nil {
+		return x.TopTransactions
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *CategoryAnomaly) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+type AcknowledgeAnomalyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Mcc      string                 `protobuf:"bytes,2,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	FlowType common.TransactionType `protobuf:"varint,3,opt,name=flow_type,json=flowType,proto3,enum=common.TransactionType" json:"flow_type,omitempty"`
+	// Any moment within the period; unset applies to every period.
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,5,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAnomalyRequest) Reset() {
+	*x = AcknowledgeAnomalyRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAnomalyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAnomalyRequest) ProtoMessage() {}
+
+func (x *AcknowledgeAnomalyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAnomalyRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAnomalyRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AcknowledgeAnomalyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcknowledgeAnomalyRequest) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *AcknowledgeAnomalyRequest) GetFlowType() common.TransactionType {
+	if x != nil {
+		return x.FlowType
+	}
+	return common.TransactionType(0)
+}
+
+func (x *AcknowledgeAnomalyRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *AcknowledgeAnomalyRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+type AcknowledgeAnomalyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAnomalyResponse) Reset() {
+	*x = AcknowledgeAnomalyResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAnomalyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAnomalyResponse) ProtoMessage() {}
+
+func (x *AcknowledgeAnomalyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAnomalyResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAnomalyResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{16}
+}
+
+type SuppressAnomalyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Mcc      string                 `protobuf:"bytes,2,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	FlowType common.TransactionType `protobuf:"varint,3,opt,name=flow_type,json=flowType,proto3,enum=common.TransactionType" json:"flow_type,omitempty"`
+	// Any moment within the period; unset applies to every period.
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,5,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuppressAnomalyRequest) Reset() {
+	*x = SuppressAnomalyRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuppressAnomalyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuppressAnomalyRequest) ProtoMessage() {}
+
+func (x *SuppressAnomalyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuppressAnomalyRequest.ProtoReflect.Descriptor instead.
+func (*SuppressAnomalyRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SuppressAnomalyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuppressAnomalyRequest) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *SuppressAnomalyRequest) GetFlowType() common.TransactionType {
+	if x != nil {
+		return x.FlowType
+	}
+	return common.TransactionType(0)
+}
+
+func (x *SuppressAnomalyRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *SuppressAnomalyRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+type SuppressAnomalyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuppressAnomalyResponse) Reset() {
+	*x = SuppressAnomalyResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuppressAnomalyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuppressAnomalyResponse) ProtoMessage() {}
+
+func (x *SuppressAnomalyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuppressAnomalyResponse.ProtoReflect.Descriptor instead.
+func (*SuppressAnomalyResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{18}
+}
+
+type UnsuppressAnomalyRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Mcc      string                 `protobuf:"bytes,2,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	FlowType common.TransactionType `protobuf:"varint,3,opt,name=flow_type,json=flowType,proto3,enum=common.TransactionType" json:"flow_type,omitempty"`
+	// The period_start of the suppression; unset lifts the permanent one.
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,5,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuppressAnomalyRequest) Reset() {
+	*x = UnsuppressAnomalyRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuppressAnomalyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuppressAnomalyRequest) ProtoMessage() {}
+
+func (x *UnsuppressAnomalyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuppressAnomalyRequest.ProtoReflect.Descriptor instead.
+func (*UnsuppressAnomalyRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnsuppressAnomalyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnsuppressAnomalyRequest) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *UnsuppressAnomalyRequest) GetFlowType() common.TransactionType {
+	if x != nil {
+		return x.FlowType
+	}
+	return common.TransactionType(0)
+}
+
+func (x *UnsuppressAnomalyRequest) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UnsuppressAnomalyRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+type UnsuppressAnomalyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuppressAnomalyResponse) Reset() {
+	*x = UnsuppressAnomalyResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuppressAnomalyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuppressAnomalyResponse) ProtoMessage() {}
+
+func (x *UnsuppressAnomalyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuppressAnomalyResponse.ProtoReflect.Descriptor instead.
+func (*UnsuppressAnomalyResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{20}
+}
+
+type BaselinePeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Amount        *common.Money          `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Weight        float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaselinePeriod) Reset() {
+	*x = BaselinePeriod{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaselinePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaselinePeriod) ProtoMessage() {}
+
+func (x *BaselinePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaselinePeriod.ProtoReflect.Descriptor instead.
+func (*BaselinePeriod) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BaselinePeriod) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *BaselinePeriod) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *BaselinePeriod) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type AnomalyTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        *common.Money          `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyTransaction) Reset() {
+	*x = AnomalyTransaction{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyTransaction) ProtoMessage() {}
+
+func (x *AnomalyTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyTransaction.ProtoReflect.Descriptor instead.
+func (*AnomalyTransaction) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AnomalyTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *AnomalyTransaction) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AnomalyTransaction) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *AnomalyTransaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AnomalyTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetTransactionAnomaliesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionAnomaliesRequest) Reset() {
+	*x = GetTransactionAnomaliesRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAnomaliesRequest) ProtoMessage() {}
+
+func (x *GetTransactionAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetTransactionAnomaliesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionAnomaliesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetTransactionAnomaliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*TransactionAnomaly  `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionAnomaliesResponse) Reset() {
+	*x = GetTransactionAnomaliesResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionAnomaliesResponse) ProtoMessage() {}
+
+func (x *GetTransactionAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetTransactionAnomaliesResponse) GetAnomalies() []*TransactionAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type TransactionAnomaly struct {
+	state            protoimpl.MessageState     `protogen:"open.v1"`
+	TransactionId    string                     `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AccountId        string                     `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount           *common.Money              `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Mcc              string                     `protobuf:"bytes,4,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Description      string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt        *timestamppb.Timestamp     `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Score            float64                    `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	AmountPercentile float64                    `protobuf:"fixed64,8,opt,name=amount_percentile,json=amountPercentile,proto3" json:"amount_percentile,omitempty"`
+	Reasons          []TransactionAnomalyReason `protobuf:"varint,9,rep,packed,name=reasons,proto3,enum=analyzer.TransactionAnomalyReason" json:"reasons,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransactionAnomaly) Reset() {
+	*x = TransactionAnomaly{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionAnomaly) ProtoMessage() {}
+
+func (x *TransactionAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionAnomaly.ProtoReflect.Descriptor instead.
+func (*TransactionAnomaly) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransactionAnomaly) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TransactionAnomaly) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactionAnomaly) GetAmount() *common.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TransactionAnomaly) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *TransactionAnomaly) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TransactionAnomaly) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransactionAnomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TransactionAnomaly) GetAmountPercentile() float64 {
+	if x != nil {
+		return x.AmountPercentile
+	}
+	return 0
+}
+
+func (x *TransactionAnomaly) GetReasons() []TransactionAnomalyReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type GetSpendingPaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSpendingPaceRequest) Reset() {
+	*x = GetSpendingPaceRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingPaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPaceRequest) ProtoMessage() {}
+
+func (x *GetSpendingPaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPaceRequest.ProtoReflect.Descriptor instead.
+func (*GetSpendingPaceRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetSpendingPaceRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetSpendingPaceRequest) GetPeriod() common.TimePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return common.TimePeriod(0)
+}
+
+type GetSpendingPaceResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	ElapsedFraction float64                `protobuf:"fixed64,3,opt,name=elapsed_fraction,json=elapsedFraction,proto3" json:"elapsed_fraction,omitempty"`
+	Categories      []*CategoryPace        `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetSpendingPaceResponse) Reset() {
+	*x = GetSpendingPaceResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSpendingPaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSpendingPaceResponse) ProtoMessage() {}
+
+func (x *GetSpendingPaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSpendingPaceResponse.ProtoReflect.Descriptor instead.
+func (*GetSpendingPaceResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSpendingPaceResponse) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *GetSpendingPaceResponse) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *GetSpendingPaceResponse) GetElapsedFraction() float64 {
+	if x != nil {
+		return x.ElapsedFraction
+	}
+	return 0
+}
+
+func (x *GetSpendingPaceResponse) GetCategories() []*CategoryPace {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryPace struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Mcc             string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	SpentAmount     *common.Money          `protobuf:"bytes,2,opt,name=spent_amount,json=spentAmount,proto3" json:"spent_amount,omitempty"`
+	ExpectedAmount  *common.Money          `protobuf:"bytes,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	ProjectedAmount *common.Money          `protobuf:"bytes,4,opt,name=projected_amount,json=projectedAmount,proto3" json:"projected_amount,omitempty"`
+	TypicalShare    float64                `protobuf:"fixed64,5,opt,name=typical_share,json=typicalShare,proto3" json:"typical_share,omitempty"`
+	PaceRatio       float64                `protobuf:"fixed64,6,opt,name=pace_ratio,json=paceRatio,proto3" json:"pace_ratio,omitempty"`
+	Exceeding       bool                   `protobuf:"varint,7,opt,name=exceeding,proto3" json:"exceeding,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CategoryPace) Reset() {
+	*x = CategoryPace{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryPace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPace) ProtoMessage() {}
+
+func (x *CategoryPace) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPace.ProtoReflect.Descriptor instead.
+func (*CategoryPace) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CategoryPace) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *CategoryPace) GetSpentAmount() *common.Money {
+	if x != nil {
+		return x.SpentAmount
+	}
+	return nil
+}
+
+func (x *CategoryPace) GetExpectedAmount() *common.Money {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return nil
+}
+
+func (x *CategoryPace) GetProjectedAmount() *common.Money {
+	if x != nil {
+		return x.ProjectedAmount
+	}
+	return nil
+}
+
+func (x *CategoryPace) GetTypicalShare() float64 {
+	if x != nil {
+		return x.TypicalShare
+	}
+	return 0
+}
+
+func (x *CategoryPace) GetPaceRatio() float64 {
+	if x != nil {
+		return x.PaceRatio
+	}
+	return 0
+}
+
+func (x *CategoryPace) GetExceeding() bool {
+	if x != nil {
+		return x.Exceeding
+	}
+	return false
+}
+
+type GetDuplicateChargesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Days          int32                  `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDuplicateChargesRequest) Reset() {
+	*x = GetDuplicateChargesRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDuplicateChargesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDuplicateChargesRequest) ProtoMessage() {}
+
+func (x *GetDuplicateChargesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDuplicateChargesRequest.ProtoReflect.Descriptor instead.
+func (*GetDuplicateChargesRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetDuplicateChargesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetDuplicateChargesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type GetDuplicateChargesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicates    []*DuplicateCharge     `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDuplicateChargesResponse) Reset() {
+	*x = GetDuplicateChargesResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDuplicateChargesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDuplicateChargesResponse) ProtoMessage() {}
+
+func (x *GetDuplicateChargesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDuplicateChargesResponse.ProtoReflect.Descriptor instead.
+func (*GetDuplicateChargesResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDuplicateChargesResponse) GetDuplicates() []*DuplicateCharge {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+type DuplicateCharge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mcc           string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	Original      *AnomalyTransaction    `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"`
+	Duplicate     *AnomalyTransaction    `protobuf:"bytes,3,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DuplicateCharge) Reset() {
+	*x = DuplicateCharge{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DuplicateCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DuplicateCharge) ProtoMessage() {}
+
+func (x *DuplicateCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DuplicateCharge.ProtoReflect.Descriptor instead.
+func (*DuplicateCharge) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DuplicateCharge) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *DuplicateCharge) GetOriginal() *AnomalyTransaction {
+	if x != nil {
+		return x.Original
+	}
+	return nil
+}
+
+func (x *DuplicateCharge) GetDuplicate() *AnomalyTransaction {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+type GetUpcomingRecurringRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingRecurringRequest) Reset() {
+	*x = GetUpcomingRecurringRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingRecurringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingRecurringRequest) ProtoMessage() {}
+
+func (x *GetUpcomingRecurringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingRecurringRequest.ProtoReflect.Descriptor instead.
+func (*GetUpcomingRecurringRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetUpcomingRecurringRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUpcomingRecurringResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*RecurringPayment    `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpcomingRecurringResponse) Reset() {
+	*x = GetUpcomingRecurringResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpcomingRecurringResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpcomingRecurringResponse) ProtoMessage() {}
+
+func (x *GetUpcomingRecurringResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpcomingRecurringResponse.ProtoReflect.Descriptor instead.
+func (*GetUpcomingRecurringResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetUpcomingRecurringResponse) GetPayments() []*RecurringPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type RecurringPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mcc           string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
+	TypicalAmount *common.Money          `protobuf:"bytes,2,opt,name=typical_amount,json=typicalAmount,proto3" json:"typical_amount,omitempty"`
+	ExpectedDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_date,json=expectedDate,proto3" json:"expected_date,omitempty"`
+	Cadence       RecurringCadence       `protobuf:"varint,4,opt,name=cadence,proto3,enum=analyzer.RecurringCadence" json:"cadence,omitempty"`
+	Merchant      string                 `protobuf:"bytes,5,opt,name=merchant,proto3" json:"merchant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecurringPayment) Reset() {
+	*x = RecurringPayment{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecurringPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecurringPayment) ProtoMessage() {}
+
+func (x *RecurringPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecurringPayment.ProtoReflect.Descriptor instead.
+func (*RecurringPayment) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RecurringPayment) GetMcc() string {
+	if x != nil {
+		return x.Mcc
+	}
+	return ""
+}
+
+func (x *RecurringPayment) GetTypicalAmount() *common.Money {
+	if x != nil {
+		return x.TypicalAmount
+	}
+	return nil
+}
+
+func (x *RecurringPayment) GetExpectedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedDate
+	}
+	return nil
 }
 
-func (x *GetAnomaliesRequest) GetUserId() string {
+func (x *RecurringPayment) GetCadence() RecurringCadence {
+	if x != nil {
+		return x.Cadence
+	}
+	return RecurringCadence_RECURRING_CADENCE_UNSPECIFIED
+}
+
+func (x *RecurringPayment) GetMerchant() string {
+	if x != nil {
+		return x.Merchant
+	}
+	return ""
+}
+
+type EvaluateForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Period        common.TimePeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=common.TimePeriod" json:"period,omitempty"`
+	Horizon       int32                  `protobuf:"varint,3,opt,name=horizon,proto3" json:"horizon,omitempty"`
+	Methods       []string               `protobuf:"bytes,4,rep,name=methods,proto3" json:"methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateForecastRequest) Reset() {
+	*x = EvaluateForecastRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateForecastRequest) ProtoMessage() {}
+
+func (x *EvaluateForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateForecastRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateForecastRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *EvaluateForecastRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetAnomaliesRequest) GetPeriod() common.TimePeriod {
+func (x *EvaluateForecastRequest) GetPeriod() common.TimePeriod {
 	if x != nil {
 		return x.Period
 	}
 	return common.TimePeriod(0)
 }
 
-type GetAnomaliesResponse struct {
+func (x *EvaluateForecastRequest) GetHorizon() int32 {
+	if x != nil {
+		return x.Horizon
+	}
+	return 0
+}
+
+func (x *EvaluateForecastRequest) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+type EvaluateForecastResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Anomalies     []*CategoryAnomaly     `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	Results       []*ForecastAccuracy    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	BestMethod    string                 `protobuf:"bytes,2,opt,name=best_method,json=bestMethod,proto3" json:"best_method,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAnomaliesResponse) Reset() {
-	*x = GetAnomaliesResponse{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[8]
+func (x *EvaluateForecastResponse) Reset() {
+	*x = EvaluateForecastResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAnomaliesResponse) String() string {
+func (x *EvaluateForecastResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAnomaliesResponse) ProtoMessage() {}
+func (*EvaluateForecastResponse) ProtoMessage() {}
 
-func (x *GetAnomaliesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[8]
+func (x *EvaluateForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -559,43 +2674,49 @@ func (x *GetAnomaliesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAnomaliesResponse.ProtoReflect.Descriptor instead.
-func (*GetAnomaliesResponse) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EvaluateForecastResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateForecastResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetAnomaliesResponse) GetAnomalies() []*CategoryAnomaly {
+func (x *EvaluateForecastResponse) GetResults() []*ForecastAccuracy {
 	if x != nil {
-		return x.Anomalies
+		return x.Results
 	}
 	return nil
 }
 
-type CategoryAnomaly struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Mcc             string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
-	ActualAmount    *common.Money          `protobuf:"bytes,2,opt,name=actual_amount,json=actualAmount,proto3" json:"actual_amount,omitempty"`
-	ExpectedAmount  *common.Money          `protobuf:"bytes,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	DeviationAmount *common.Money          `protobuf:"bytes,4,opt,name=deviation_amount,json=deviationAmount,proto3" json:"deviation_amount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *EvaluateForecastResponse) GetBestMethod() string {
+	if x != nil {
+		return x.BestMethod
+	}
+	return ""
 }
 
-func (x *CategoryAnomaly) Reset() {
-	*x = CategoryAnomaly{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[9]
+type ForecastAccuracy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Method        string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Income        *AccuracyMetrics       `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"`
+	Expense       *AccuracyMetrics       `protobuf:"bytes,3,opt,name=expense,proto3" json:"expense,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastAccuracy) Reset() {
+	*x = ForecastAccuracy{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CategoryAnomaly) String() string {
+func (x *ForecastAccuracy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CategoryAnomaly) ProtoMessage() {}
+func (*ForecastAccuracy) ProtoMessage() {}
 
-func (x *CategoryAnomaly) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[9]
+func (x *ForecastAccuracy) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,61 +2727,125 @@ func (x *CategoryAnomaly) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CategoryAnomaly.ProtoReflect.Descriptor instead.
-func (*CategoryAnomaly) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ForecastAccuracy.ProtoReflect.Descriptor instead.
+func (*ForecastAccuracy) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CategoryAnomaly) GetMcc() string {
+func (x *ForecastAccuracy) GetMethod() string {
 	if x != nil {
-		return x.Mcc
+		return x.Method
 	}
 	return ""
 }
 
-func (x *CategoryAnomaly) GetActualAmount() *common.Money {
+func (x *ForecastAccuracy) GetIncome() *AccuracyMetrics {
 	if x != nil {
-		return x.ActualAmount
+		return x.Income
 	}
 	return nil
 }
 
-func (x *CategoryAnomaly) GetExpectedAmount() *common.Money {
+func (x *ForecastAccuracy) GetExpense() *AccuracyMetrics {
 	if x != nil {
-		return x.ExpectedAmount
+		return x.Expense
 	}
 	return nil
 }
 
-func (x *CategoryAnomaly) GetDeviationAmount() *common.Money {
+type AccuracyMetrics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mae           float64                `protobuf:"fixed64,1,opt,name=mae,proto3" json:"mae,omitempty"`
+	Mape          float64                `protobuf:"fixed64,2,opt,name=mape,proto3" json:"mape,omitempty"`
+	Bias          float64                `protobuf:"fixed64,3,opt,name=bias,proto3" json:"bias,omitempty"`
+	Samples       int32                  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccuracyMetrics) Reset() {
+	*x = AccuracyMetrics{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccuracyMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccuracyMetrics) ProtoMessage() {}
+
+func (x *AccuracyMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[38]
 	if x != nil {
-		return x.DeviationAmount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type GetUpcomingRecurringRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use AccuracyMetrics.ProtoReflect.Descriptor instead.
+func (*AccuracyMetrics) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetUpcomingRecurringRequest) Reset() {
-	*x = GetUpcomingRecurringRequest{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[10]
+func (x *AccuracyMetrics) GetMae() float64 {
+	if x != nil {
+		return x.Mae
+	}
+	return 0
+}
+
+func (x *AccuracyMetrics) GetMape() float64 {
+	if x != nil {
+		return x.Mape
+	}
+	return 0
+}
+
+func (x *AccuracyMetrics) GetBias() float64 {
+	if x != nil {
+		return x.Bias
+	}
+	return 0
+}
+
+func (x *AccuracyMetrics) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+type GetCashFlowProjectionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	HorizonDays    int32                  `protobuf:"varint,2,opt,name=horizon_days,json=horizonDays,proto3" json:"horizon_days,omitempty"`
+	Threshold      *common.Money          `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	CurrentBalance *common.Money          `protobuf:"bytes,4,opt,name=current_balance,json=currentBalance,proto3" json:"current_balance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCashFlowProjectionRequest) Reset() {
+	*x = GetCashFlowProjectionRequest{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingRecurringRequest) String() string {
+func (x *GetCashFlowProjectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingRecurringRequest) ProtoMessage() {}
+func (*GetCashFlowProjectionRequest) ProtoMessage() {}
 
-func (x *GetUpcomingRecurringRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[10]
+func (x *GetCashFlowProjectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,40 +2856,65 @@ func (x *GetUpcomingRecurringRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingRecurringRequest.ProtoReflect.Descriptor instead.
-func (*GetUpcomingRecurringRequest) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetCashFlowProjectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCashFlowProjectionRequest) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetUpcomingRecurringRequest) GetUserId() string {
+func (x *GetCashFlowProjectionRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUpcomingRecurringResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payments      []*RecurringPayment    `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCashFlowProjectionRequest) GetHorizonDays() int32 {
+	if x != nil {
+		return x.HorizonDays
+	}
+	return 0
 }
 
-func (x *GetUpcomingRecurringResponse) Reset() {
-	*x = GetUpcomingRecurringResponse{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[11]
+func (x *GetCashFlowProjectionRequest) GetThreshold() *common.Money {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *GetCashFlowProjectionRequest) GetCurrentBalance() *common.Money {
+	if x != nil {
+		return x.CurrentBalance
+	}
+	return nil
+}
+
+type GetCashFlowProjectionResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StartingBalance    *common.Money          `protobuf:"bytes,1,opt,name=starting_balance,json=startingBalance,proto3" json:"starting_balance,omitempty"`
+	DailyDiscretionary *common.Money          `protobuf:"bytes,2,opt,name=daily_discretionary,json=dailyDiscretionary,proto3" json:"daily_discretionary,omitempty"`
+	Days               []*DailyBalance        `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+	BelowZeroDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=below_zero_date,json=belowZeroDate,proto3" json:"below_zero_date,omitempty"`
+	BelowThresholdDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=below_threshold_date,json=belowThresholdDate,proto3" json:"below_threshold_date,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCashFlowProjectionResponse) Reset() {
+	*x = GetCashFlowProjectionResponse{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpcomingRecurringResponse) String() string {
+func (x *GetCashFlowProjectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpcomingRecurringResponse) ProtoMessage() {}
+func (*GetCashFlowProjectionResponse) ProtoMessage() {}
 
-func (x *GetUpcomingRecurringResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[11]
+func (x *GetCashFlowProjectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,42 +2925,71 @@ func (x *GetUpcomingRecurringResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpcomingRecurringResponse.ProtoReflect.Descriptor instead.
-func (*GetUpcomingRecurringResponse) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetCashFlowProjectionResponse.ProtoReflect.Descriptor instead.
+func (*GetCashFlowProjectionResponse) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetUpcomingRecurringResponse) GetPayments() []*RecurringPayment {
+func (x *GetCashFlowProjectionResponse) GetStartingBalance() *common.Money {
 	if x != nil {
-		return x.Payments
+		return x.StartingBalance
 	}
 	return nil
 }
 
-type RecurringPayment struct {
+func (x *GetCashFlowProjectionResponse) GetDailyDiscretionary() *common.Money {
+	if x != nil {
+		return x.DailyDiscretionary
+	}
+	return nil
+}
+
+func (x *GetCashFlowProjectionResponse) GetDays() []*DailyBalance {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetCashFlowProjectionResponse) GetBelowZeroDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BelowZeroDate
+	}
+	return nil
+}
+
+func (x *GetCashFlowProjectionResponse) GetBelowThresholdDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BelowThresholdDate
+	}
+	return nil
+}
+
+type DailyBalance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mcc           string                 `protobuf:"bytes,1,opt,name=mcc,proto3" json:"mcc,omitempty"`
-	TypicalAmount *common.Money          `protobuf:"bytes,2,opt,name=typical_amount,json=typicalAmount,proto3" json:"typical_amount,omitempty"`
-	ExpectedDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_date,json=expectedDate,proto3" json:"expected_date,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Income        *common.Money          `protobuf:"bytes,2,opt,name=income,proto3" json:"income,omitempty"`
+	Expense       *common.Money          `protobuf:"bytes,3,opt,name=expense,proto3" json:"expense,omitempty"`
+	Balance       *common.Money          `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecurringPayment) Reset() {
-	*x = RecurringPayment{}
-	mi := &file_analyzer_analyzer_proto_msgTypes[12]
+func (x *DailyBalance) Reset() {
+	*x = DailyBalance{}
+	mi := &file_analyzer_analyzer_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecurringPayment) String() string {
+func (x *DailyBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecurringPayment) ProtoMessage() {}
+func (*DailyBalance) ProtoMessage() {}
 
-func (x *RecurringPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_analyzer_analyzer_proto_msgTypes[12]
+func (x *DailyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_analyzer_analyzer_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,28 +3000,35 @@ func (x *RecurringPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecurringPayment.ProtoReflect.Descriptor instead.
-func (*RecurringPayment) Descriptor() ([]byte, []int) {
-	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DailyBalance.ProtoReflect.Descriptor instead.
+func (*DailyBalance) Descriptor() ([]byte, []int) {
+	return file_analyzer_analyzer_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RecurringPayment) GetMcc() string {
+func (x *DailyBalance) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Mcc
+		return x.Date
 	}
-	return ""
+	return nil
 }
 
-func (x *RecurringPayment) GetTypicalAmount() *common.Money {
+func (x *DailyBalance) GetIncome() *common.Money {
 	if x != nil {
-		return x.TypicalAmount
+		return x.Income
 	}
 	return nil
 }
 
-func (x *RecurringPayment) GetExpectedDate() *timestamppb.Timestamp {
+func (x *DailyBalance) GetExpense() *common.Money {
 	if x != nil {
-		return x.ExpectedDate
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *DailyBalance) GetBalance() *common.Money {
+	if x != nil {
+		return x.Balance
 	}
 	return nil
 }
@@ -803,7 +3049,7 @@ const file_analyzer_analyzer_proto_rawDesc = "" +
 	"\x10CategorySpending\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\tR\n" +
 	"categoryId\x120\n" +
-	"\ftotal_amount\x18\x02 \x01(\v2\r.common.MoneyR\vtotalAmount\"\xfb\x02\n" +
+	"\ftotal_amount\x18\x02 \x01(\v2\r.common.MoneyR\vtotalAmount\"\xb5\x04\n" +
 	"\bForecast\x12=\n" +
 	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
 	"\n" +
@@ -811,47 +3057,243 @@ const file_analyzer_analyzer_proto_rawDesc = "" +
 	"\x0fexpected_income\x18\x03 \x01(\v2\r.common.MoneyR\x0eexpectedIncome\x128\n" +
 	"\x10expected_expense\x18\x04 \x01(\v2\r.common.MoneyR\x0fexpectedExpense\x128\n" +
 	"\x10expected_balance\x18\x05 \x01(\v2\r.common.MoneyR\x0fexpectedBalance\x12I\n" +
-	"\x12category_breakdown\x18\x06 \x03(\v2\x1a.analyzer.CategorySpendingR\x11categoryBreakdown\"\xd0\x01\n" +
+	"\x12category_breakdown\x18\x06 \x03(\v2\x1a.analyzer.CategorySpendingR\x11categoryBreakdown\x128\n" +
+	"\tintervals\x18\a \x03(\v2\x1a.analyzer.ForecastIntervalR\tintervals\x12:\n" +
+	"\x11committed_expense\x18\b \x01(\v2\r.common.MoneyR\x10committedExpense\x12B\n" +
+	"\x15discretionary_expense\x18\t \x01(\v2\r.common.MoneyR\x14discretionaryExpense\"\xdc\x02\n" +
+	"\x10ForecastInterval\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x01R\x05level\x120\n" +
+	"\fincome_lower\x18\x02 \x01(\v2\r.common.MoneyR\vincomeLower\x120\n" +
+	"\fincome_upper\x18\x03 \x01(\v2\r.common.MoneyR\vincomeUpper\x122\n" +
+	"\rexpense_lower\x18\x04 \x01(\v2\r.common.MoneyR\fexpenseLower\x122\n" +
+	"\rexpense_upper\x18\x05 \x01(\v2\r.common.MoneyR\fexpenseUpper\x122\n" +
+	"\rbalance_lower\x18\x06 \x01(\v2\r.common.MoneyR\fbalanceLower\x122\n" +
+	"\rbalance_upper\x18\a \x01(\v2\r.common.MoneyR\fbalanceUpper\"\xfb\x01\n" +
 	"\x14GetStatisticsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
 	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12-\n" +
-	"\bgroup_by\x18\x04 \x01(\x0e2\x12.common.TimePeriodR\agroupBy\"\xb7\x01\n" +
+	"\bgroup_by\x18\x04 \x01(\x0e2\x12.common.TimePeriodR\agroupBy\x12\x1b\n" +
+	"\tfill_gaps\x18\x06 \x01(\bR\bfillGapsJ\x04\b\x05\x10\x06R\x06sparse\"\xb7\x01\n" +
 	"\x15GetStatisticsResponse\x120\n" +
 	"\ftotal_income\x18\x01 \x01(\v2\r.common.MoneyR\vtotalIncome\x122\n" +
 	"\rtotal_expense\x18\x02 \x01(\v2\r.common.MoneyR\ftotalExpense\x128\n" +
 	"\vperiod_data\x18\x04 \x03(\v2\x17.analyzer.PeriodBalanceR\n" +
-	"periodData\"~\n" +
+	"periodData\"\x96\x01\n" +
 	"\x12GetForecastRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
 	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12#\n" +
-	"\rperiods_ahead\x18\x03 \x01(\x05R\fperiodsAhead\"G\n" +
+	"\rperiods_ahead\x18\x03 \x01(\x05R\fperiodsAhead\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\"\xd9\x01\n" +
 	"\x13GetForecastResponse\x120\n" +
-	"\tforecasts\x18\x01 \x03(\v2\x12.analyzer.ForecastR\tforecasts\"Z\n" +
+	"\tforecasts\x18\x01 \x03(\v2\x12.analyzer.ForecastR\tforecasts\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12:\n" +
+	"\fincome_trend\x18\x03 \x01(\v2\x17.analyzer.ForecastTrendR\vincomeTrend\x12<\n" +
+	"\rexpense_trend\x18\x04 \x01(\v2\x17.analyzer.ForecastTrendR\fexpenseTrend\"J\n" +
+	"\rForecastTrend\x12\x14\n" +
+	"\x05slope\x18\x01 \x01(\x01R\x05slope\x12#\n" +
+	"\rslope_percent\x18\x02 \x01(\x01R\fslopePercent\"\x99\x01\n" +
 	"\x13GetAnomaliesRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
-	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\"O\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12=\n" +
+	"\fperiod_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\"\x8c\x01\n" +
 	"\x14GetAnomaliesResponse\x127\n" +
-	"\tanomalies\x18\x01 \x03(\v2\x19.analyzer.CategoryAnomalyR\tanomalies\"\xc9\x01\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x19.analyzer.CategoryAnomalyR\tanomalies\x12;\n" +
+	"\n" +
+	"thresholds\x18\x02 \x01(\v2\x1b.analyzer.AnomalyThresholdsR\n" +
+	"thresholds\"\x9a\x02\n" +
+	"\x11AnomalyThresholds\x12/\n" +
+	"\x13deviation_threshold\x18\x01 \x01(\x01R\x12deviationThreshold\x12D\n" +
+	"\x10deviation_source\x18\x02 \x01(\x0e2\x19.analyzer.ThresholdSourceR\x0fdeviationSource\x12C\n" +
+	"\x16new_category_threshold\x18\x03 \x01(\v2\r.common.MoneyR\x14newCategoryThreshold\x12I\n" +
+	"\x13new_category_source\x18\x04 \x01(\x0e2\x19.analyzer.ThresholdSourceR\x11newCategorySource\"\xc9\x01\n" +
+	"\x1bSetAnomalyThresholdsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\x13deviation_threshold\x18\x02 \x01(\x01H\x00R\x12deviationThreshold\x88\x01\x01\x12C\n" +
+	"\x16new_category_threshold\x18\x03 \x01(\v2\r.common.MoneyR\x14newCategoryThresholdB\x16\n" +
+	"\x14_deviation_threshold\"\x1e\n" +
+	"\x1cSetAnomalyThresholdsResponse\"\xe8\x04\n" +
 	"\x0fCategoryAnomaly\x12\x10\n" +
 	"\x03mcc\x18\x01 \x01(\tR\x03mcc\x122\n" +
 	"\ractual_amount\x18\x02 \x01(\v2\r.common.MoneyR\factualAmount\x126\n" +
 	"\x0fexpected_amount\x18\x03 \x01(\v2\r.common.MoneyR\x0eexpectedAmount\x128\n" +
-	"\x10deviation_amount\x18\x04 \x01(\v2\r.common.MoneyR\x0fdeviationAmount\"6\n" +
+	"\x10deviation_amount\x18\x04 \x01(\v2\r.common.MoneyR\x0fdeviationAmount\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\x125\n" +
+	"\bseverity\x18\x06 \x01(\x0e2\x19.analyzer.AnomalySeverityR\bseverity\x128\n" +
+	"\tdirection\x18\a \x01(\x0e2\x1a.analyzer.AnomalyDirectionR\tdirection\x124\n" +
+	"\tflow_type\x18\b \x01(\x0e2\x17.common.TransactionTypeR\bflowType\x124\n" +
+	"\bbaseline\x18\t \x03(\v2\x18.analyzer.BaselinePeriodR\bbaseline\x12G\n" +
+	"\x10top_transactions\x18\n" +
+	" \x03(\v2\x1c.analyzer.AnomalyTransactionR\x0ftopTransactions\x12=\n" +
+	"\fperiod_start\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\"\n" +
+	"\facknowledged\x18\f \x01(\bR\facknowledged\"\xe7\x01\n" +
+	"\x19AcknowledgeAnomalyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03mcc\x18\x02 \x01(\tR\x03mcc\x124\n" +
+	"\tflow_type\x18\x03 \x01(\x0e2\x17.common.TransactionTypeR\bflowType\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12*\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x12.common.TimePeriodR\x06period\"\x1c\n" +
+	"\x1aAcknowledgeAnomalyResponse\"\xe4\x01\n" +
+	"\x16SuppressAnomalyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03mcc\x18\x02 \x01(\tR\x03mcc\x124\n" +
+	"\tflow_type\x18\x03 \x01(\x0e2\x17.common.TransactionTypeR\bflowType\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12*\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x12.common.TimePeriodR\x06period\"\x19\n" +
+	"\x17SuppressAnomalyResponse\"\xe6\x01\n" +
+	"\x18UnsuppressAnomalyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03mcc\x18\x02 \x01(\tR\x03mcc\x124\n" +
+	"\tflow_type\x18\x03 \x01(\x0e2\x17.common.TransactionTypeR\bflowType\x12=\n" +
+	"\fperiod_start\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12*\n" +
+	"\x06period\x18\x05 \x01(\x0e2\x12.common.TimePeriodR\x06period\"\x1b\n" +
+	"\x19UnsuppressAnomalyResponse\"\x8e\x01\n" +
+	"\x0eBaselinePeriod\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12%\n" +
+	"\x06amount\x18\x02 \x01(\v2\r.common.MoneyR\x06amount\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\"\xde\x01\n" +
+	"\x12AnomalyTransaction\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12%\n" +
+	"\x06amount\x18\x03 \x01(\v2\r.common.MoneyR\x06amount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"M\n" +
+	"\x1eGetTransactionAnomaliesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"]\n" +
+	"\x1fGetTransactionAnomaliesResponse\x12:\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x1c.analyzer.TransactionAnomalyR\tanomalies\"\xf1\x02\n" +
+	"\x12TransactionAnomaly\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12%\n" +
+	"\x06amount\x18\x03 \x01(\v2\r.common.MoneyR\x06amount\x12\x10\n" +
+	"\x03mcc\x18\x04 \x01(\tR\x03mcc\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05score\x18\a \x01(\x01R\x05score\x12+\n" +
+	"\x11amount_percentile\x18\b \x01(\x01R\x10amountPercentile\x12<\n" +
+	"\areasons\x18\t \x03(\x0e2\".analyzer.TransactionAnomalyReasonR\areasons\"]\n" +
+	"\x16GetSpendingPaceRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\"\xf6\x01\n" +
+	"\x17GetSpendingPaceResponse\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x12)\n" +
+	"\x10elapsed_fraction\x18\x03 \x01(\x01R\x0felapsedFraction\x126\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\x16.analyzer.CategoryPaceR\n" +
+	"categories\"\xa6\x02\n" +
+	"\fCategoryPace\x12\x10\n" +
+	"\x03mcc\x18\x01 \x01(\tR\x03mcc\x120\n" +
+	"\fspent_amount\x18\x02 \x01(\v2\r.common.MoneyR\vspentAmount\x126\n" +
+	"\x0fexpected_amount\x18\x03 \x01(\v2\r.common.MoneyR\x0eexpectedAmount\x128\n" +
+	"\x10projected_amount\x18\x04 \x01(\v2\r.common.MoneyR\x0fprojectedAmount\x12#\n" +
+	"\rtypical_share\x18\x05 \x01(\x01R\ftypicalShare\x12\x1d\n" +
+	"\n" +
+	"pace_ratio\x18\x06 \x01(\x01R\tpaceRatio\x12\x1c\n" +
+	"\texceeding\x18\a \x01(\bR\texceeding\"I\n" +
+	"\x1aGetDuplicateChargesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"X\n" +
+	"\x1bGetDuplicateChargesResponse\x129\n" +
+	"\n" +
+	"duplicates\x18\x01 \x03(\v2\x19.analyzer.DuplicateChargeR\n" +
+	"duplicates\"\x99\x01\n" +
+	"\x0fDuplicateCharge\x12\x10\n" +
+	"\x03mcc\x18\x01 \x01(\tR\x03mcc\x128\n" +
+	"\boriginal\x18\x02 \x01(\v2\x1c.analyzer.AnomalyTransactionR\boriginal\x12:\n" +
+	"\tduplicate\x18\x03 \x01(\v2\x1c.analyzer.AnomalyTransactionR\tduplicate\"6\n" +
 	"\x1bGetUpcomingRecurringRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"V\n" +
 	"\x1cGetUpcomingRecurringResponse\x126\n" +
-	"\bpayments\x18\x01 \x03(\v2\x1a.analyzer.RecurringPaymentR\bpayments\"\x9b\x01\n" +
+	"\bpayments\x18\x01 \x03(\v2\x1a.analyzer.RecurringPaymentR\bpayments\"\xed\x01\n" +
 	"\x10RecurringPayment\x12\x10\n" +
 	"\x03mcc\x18\x01 \x01(\tR\x03mcc\x124\n" +
 	"\x0etypical_amount\x18\x02 \x01(\v2\r.common.MoneyR\rtypicalAmount\x12?\n" +
-	"\rexpected_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fexpectedDate2\xe5\x02\n" +
+	"\rexpected_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fexpectedDate\x124\n" +
+	"\acadence\x18\x04 \x01(\x0e2\x1a.analyzer.RecurringCadenceR\acadence\x12\x1a\n" +
+	"\bmerchant\x18\x05 \x01(\tR\bmerchant\"\x92\x01\n" +
+	"\x17EvaluateForecastRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x12.common.TimePeriodR\x06period\x12\x18\n" +
+	"\ahorizon\x18\x03 \x01(\x05R\ahorizon\x12\x18\n" +
+	"\amethods\x18\x04 \x03(\tR\amethods\"q\n" +
+	"\x18EvaluateForecastResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.analyzer.ForecastAccuracyR\aresults\x12\x1f\n" +
+	"\vbest_method\x18\x02 \x01(\tR\n" +
+	"bestMethod\"\x92\x01\n" +
+	"\x10ForecastAccuracy\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x121\n" +
+	"\x06income\x18\x02 \x01(\v2\x19.analyzer.AccuracyMetricsR\x06income\x123\n" +
+	"\aexpense\x18\x03 \x01(\v2\x19.analyzer.AccuracyMetricsR\aexpense\"e\n" +
+	"\x0fAccuracyMetrics\x12\x10\n" +
+	"\x03mae\x18\x01 \x01(\x01R\x03mae\x12\x12\n" +
+	"\x04mape\x18\x02 \x01(\x01R\x04mape\x12\x12\n" +
+	"\x04bias\x18\x03 \x01(\x01R\x04bias\x12\x18\n" +
+	"\asamples\x18\x04 \x01(\x05R\asamples\"\xbf\x01\n" +
+	"\x1cGetCashFlowProjectionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fhorizon_days\x18\x02 \x01(\x05R\vhorizonDays\x12+\n" +
+	"\tthreshold\x18\x03 \x01(\v2\r.common.MoneyR\tthreshold\x126\n" +
+	"\x0fcurrent_balance\x18\x04 \x01(\v2\r.common.MoneyR\x0ecurrentBalance\"\xd7\x02\n" +
+	"\x1dGetCashFlowProjectionResponse\x128\n" +
+	"\x10starting_balance\x18\x01 \x01(\v2\r.common.MoneyR\x0fstartingBalance\x12>\n" +
+	"\x13daily_discretionary\x18\x02 \x01(\v2\r.common.MoneyR\x12dailyDiscretionary\x12*\n" +
+	"\x04days\x18\x03 \x03(\v2\x16.analyzer.DailyBalanceR\x04days\x12B\n" +
+	"\x0fbelow_zero_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rbelowZeroDate\x12L\n" +
+	"\x14below_threshold_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12belowThresholdDate\"\xb7\x01\n" +
+	"\fDailyBalance\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12%\n" +
+	"\x06income\x18\x02 \x01(\v2\r.common.MoneyR\x06income\x12'\n" +
+	"\aexpense\x18\x03 \x01(\v2\r.common.MoneyR\aexpense\x12'\n" +
+	"\abalance\x18\x04 \x01(\v2\r.common.MoneyR\abalance*\x8a\x01\n" +
+	"\x0fThresholdSource\x12 \n" +
+	"\x1cTHRESHOLD_SOURCE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17THRESHOLD_SOURCE_CONFIG\x10\x01\x12\x1d\n" +
+	"\x19THRESHOLD_SOURCE_ADAPTIVE\x10\x02\x12\x19\n" +
+	"\x15THRESHOLD_SOURCE_USER\x10\x03*o\n" +
+	"\x10AnomalyDirection\x12!\n" +
+	"\x1dANOMALY_DIRECTION_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ANOMALY_DIRECTION_ABOVE\x10\x01\x12\x1b\n" +
+	"\x17ANOMALY_DIRECTION_BELOW\x10\x02*\x85\x01\n" +
+	"\x0fAnomalySeverity\x12 \n" +
+	"\x1cANOMALY_SEVERITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ANOMALY_SEVERITY_LOW\x10\x01\x12\x1b\n" +
+	"\x17ANOMALY_SEVERITY_MEDIUM\x10\x02\x12\x19\n" +
+	"\x15ANOMALY_SEVERITY_HIGH\x10\x03*\xd9\x01\n" +
+	"\x18TransactionAnomalyReason\x12*\n" +
+	"&TRANSACTION_ANOMALY_REASON_UNSPECIFIED\x10\x00\x120\n" +
+	",TRANSACTION_ANOMALY_REASON_AMOUNT_PERCENTILE\x10\x01\x12+\n" +
+	"'TRANSACTION_ANOMALY_REASON_UNUSUAL_HOUR\x10\x02\x122\n" +
+	".TRANSACTION_ANOMALY_REASON_FIRST_TIME_MERCHANT\x10\x03*\xf3\x01\n" +
+	"\x10RecurringCadence\x12!\n" +
+	"\x1dRECURRING_CADENCE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18RECURRING_CADENCE_WEEKLY\x10\x01\x12\x1e\n" +
+	"\x1aRECURRING_CADENCE_BIWEEKLY\x10\x02\x12\x1d\n" +
+	"\x19RECURRING_CADENCE_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRING_CADENCE_QUARTERLY\x10\x04\x12 \n" +
+	"\x1cRECURRING_CADENCE_SEMIANNUAL\x10\x05\x12\x1c\n" +
+	"\x18RECURRING_CADENCE_ANNUAL\x10\x062\xd4\t\n" +
 	"\x0fAnalyzerService\x12P\n" +
 	"\rGetStatistics\x12\x1e.analyzer.GetStatisticsRequest\x1a\x1f.analyzer.GetStatisticsResponse\x12J\n" +
 	"\vGetForecast\x12\x1c.analyzer.GetForecastRequest\x1a\x1d.analyzer.GetForecastResponse\x12M\n" +
-	"\fGetAnomalies\x12\x1d.analyzer.GetAnomaliesRequest\x1a\x1e.analyzer.GetAnomaliesResponse\x12e\n" +
-	"\x14GetUpcomingRecurring\x12%.analyzer.GetUpcomingRecurringRequest\x1a&.analyzer.GetUpcomingRecurringResponseB\x0eZ\fapi-analyzerb\x06proto3"
+	"\fGetAnomalies\x12\x1d.analyzer.GetAnomaliesRequest\x1a\x1e.analyzer.GetAnomaliesResponse\x12_\n" +
+	"\x12AcknowledgeAnomaly\x12#.analyzer.AcknowledgeAnomalyRequest\x1a$.analyzer.AcknowledgeAnomalyResponse\x12V\n" +
+	"\x0fSuppressAnomaly\x12 .analyzer.SuppressAnomalyRequest\x1a!.analyzer.SuppressAnomalyResponse\x12\\\n" +
+	"\x11UnsuppressAnomaly\x12\".analyzer.UnsuppressAnomalyRequest\x1a#.analyzer.UnsuppressAnomalyResponse\x12e\n" +
+	"\x14SetAnomalyThresholds\x12%.analyzer.SetAnomalyThresholdsRequest\x1a&.analyzer.SetAnomalyThresholdsResponse\x12n\n" +
+	"\x17GetTransactionAnomalies\x12(.analyzer.GetTransactionAnomaliesRequest\x1a).analyzer.GetTransactionAnomaliesResponse\x12V\n" +
+	"\x0fGetSpendingPace\x12 .analyzer.GetSpendingPaceRequest\x1a!.analyzer.GetSpendingPaceResponse\x12b\n" +
+	"\x13GetDuplicateCharges\x12$.analyzer.GetDuplicateChargesRequest\x1a%.analyzer.GetDuplicateChargesResponse\x12e\n" +
+	"\x14GetUpcomingRecurring\x12%.analyzer.GetUpcomingRecurringRequest\x1a&.analyzer.GetUpcomingRecurringResponse\x12Y\n" +
+	"\x10EvaluateForecast\x12!.analyzer.EvaluateForecastRequest\x1a\".analyzer.EvaluateForecastResponse\x12h\n" +
+	"\x15GetCashFlowProjection\x12&.analyzer.GetCashFlowProjectionRequest\x1a'.analyzer.GetCashFlowProjectionResponseB\x0eZ\fapi-analyzerb\x06proto3"
 
 var (
 	file_analyzer_analyzer_proto_rawDescOnce sync.Once
@@ -865,68 +3307,188 @@ func file_analyzer_analyzer_proto_rawDescGZIP() []byte {
 	return file_analyzer_analyzer_proto_rawDescData
 }
 
-var file_analyzer_analyzer_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_analyzer_analyzer_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_analyzer_analyzer_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_analyzer_analyzer_proto_goTypes = []any{
-	(*PeriodBalance)(nil),                // 0: analyzer.PeriodBalance
-	(*CategorySpending)(nil),             // 1: analyzer.CategorySpending
-	(*Forecast)(nil),                     // 2: analyzer.Forecast
-	(*GetStatisticsRequest)(nil),         // 3: analyzer.GetStatisticsRequest
-	(*GetStatisticsResponse)(nil),        // 4: analyzer.GetStatisticsResponse
-	(*GetForecastRequest)(nil),           // 5: analyzer.GetForecastRequest
-	(*GetForecastResponse)(nil),          // 6: analyzer.GetForecastResponse
-	(*GetAnomaliesRequest)(nil),          // 7: analyzer.GetAnomaliesRequest
-	(*GetAnomaliesResponse)(nil),         // 8: analyzer.GetAnomaliesResponse
-	(*CategoryAnomaly)(nil),              // 9: analyzer.CategoryAnomaly
-	(*GetUpcomingRecurringRequest)(nil),  // 10: analyzer.GetUpcomingRecurringRequest
-	(*GetUpcomingRecurringResponse)(nil), // 11: analyzer.GetUpcomingRecurringResponse
-	(*RecurringPayment)(nil),             // 12: analyzer.RecurringPayment
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
-	(*common.Money)(nil),                 // 14: common.Money
-	(common.TimePeriod)(0),               // 15: common.TimePeriod
+	(ThresholdSource)(0),                    // 0: analyzer.ThresholdSource
+	(AnomalyDirection)(0),                   // 1: analyzer.AnomalyDirection
+	(AnomalySeverity)(0),                    // 2: analyzer.AnomalySeverity
+	(TransactionAnomalyReason)(0),           // 3: analyzer.TransactionAnomalyReason
+	(RecurringCadence)(0),                   // 4: analyzer.RecurringCadence
+	(*PeriodBalance)(nil),                   // 5: analyzer.PeriodBalance
+	(*CategorySpending)(nil),                // 6: analyzer.CategorySpending
+	(*Forecast)(nil),                        // 7: analyzer.Forecast
+	(*ForecastInterval)(nil),                // 8: analyzer.ForecastInterval
+	(*GetStatisticsRequest)(nil),            // 9: analyzer.GetStatisticsRequest
+	(*GetStatisticsResponse)(nil),           // 10: analyzer.GetStatisticsResponse
+	(*GetForecastRequest)(nil),              // 11: analyzer.GetForecastRequest
+	(*GetForecastResponse)(nil),             // 12: analyzer.GetForecastResponse
+	(*ForecastTrend)(nil),                   // 13: analyzer.ForecastTrend
+	(*GetAnomaliesRequest)(nil),             // 14: analyzer.GetAnomaliesRequest
+	(*GetAnomaliesResponse)(nil),            // 15: analyzer.GetAnomaliesResponse
+	(*AnomalyThresholds)(nil),               // 16: analyzer.AnomalyThresholds
+	(*SetAnomalyThresholdsRequest)(nil),     // 17: analyzer.SetAnomalyThresholdsRequest
+	(*SetAnomalyThresholdsResponse)(nil),    // 18: analyzer.SetAnomalyThresholdsResponse
+	(*CategoryAnomaly)(nil),                 // 19: analyzer.CategoryAnomaly
+	(*AcknowledgeAnomalyRequest)(nil),       // 20: analyzer.AcknowledgeAnomalyRequest
+	(*AcknowledgeAnomalyResponse)(nil),      // 21: analyzer.AcknowledgeAnomalyResponse
+	(*SuppressAnomalyRequest)(nil),          // 22: analyzer.SuppressAnomalyRequest
+	(*SuppressAnomalyResponse)(nil),         // 23: analyzer.SuppressAnomalyResponse
+	(*UnsuppressAnomalyRequest)(nil),        // 24: analyzer.UnsuppressAnomalyRequest
+	(*UnsuppressAnomalyResponse)(nil),       // 25: analyzer.UnsuppressAnomalyResponse
+	(*BaselinePeriod)(nil),                  // 26: analyzer.BaselinePeriod
+	(*AnomalyTransaction)(nil),              // 27: analyzer.AnomalyTransaction
+	(*GetTransactionAnomaliesRequest)(nil),  // 28: analyzer.GetTransactionAnomaliesRequest
+	(*GetTransactionAnomaliesResponse)(nil), // 29: analyzer.GetTransactionAnomaliesResponse
+	(*TransactionAnomaly)(nil),              // 30: analyzer.TransactionAnomaly
+	(*GetSpendingPaceRequest)(nil),          // 31: analyzer.GetSpendingPaceRequest
+	(*GetSpendingPaceResponse)(nil),         // 32: analyzer.GetSpendingPaceResponse
+	(*CategoryPace)(nil),                    // 33: analyzer.CategoryPace
+	(*GetDuplicateChargesRequest)(nil),      // 34: analyzer.GetDuplicateChargesRequest
+	(*GetDuplicateChargesResponse)(nil),     // 35: analyzer.GetDuplicateChargesResponse
+	(*DuplicateCharge)(nil),                 // 36: analyzer.DuplicateCharge
+	(*GetUpcomingRecurringRequest)(nil),     // 37: analyzer.GetUpcomingRecurringRequest
+	(*GetUpcomingRecurringResponse)(nil),    // 38: analyzer.GetUpcomingRecurringResponse
+	(*RecurringPayment)(nil),                // 39: analyzer.RecurringPayment
+	(*EvaluateForecastRequest)(nil),         // 40: analyzer.EvaluateForecastRequest
+	(*EvaluateForecastResponse)(nil),        // 41: analyzer.EvaluateForecastResponse
+	(*ForecastAccuracy)(nil),                // 42: analyzer.ForecastAccuracy
+	(*AccuracyMetrics)(nil),                 // 43: analyzer.AccuracyMetrics
+	(*GetCashFlowProjectionRequest)(nil),    // 44: analyzer.GetCashFlowProjectionRequest
+	(*GetCashFlowProjectionResponse)(nil),   // 45: analyzer.GetCashFlowProjectionResponse
+	(*DailyBalance)(nil),                    // 46: analyzer.DailyBalance
+	(*timestamppb.Timestamp)(nil),           // 47: google.protobuf.Timestamp
+	(*common.Money)(nil),                    // 48: common.Money
+	(common.TimePeriod)(0),                  // 49: common.TimePeriod
+	(common.TransactionType)(0),             // 50: common.TransactionType
 }
 var file_analyzer_analyzer_proto_depIdxs = []int32{
-	13, // 0: analyzer.PeriodBalance.period_start:type_name -> google.protobuf.Timestamp
-	13, // 1: analyzer.PeriodBalance.period_end:type_name -> google.protobuf.Timestamp
-	14, // 2: analyzer.PeriodBalance.income:type_name -> common.Money
-	14, // 3: analyzer.PeriodBalance.expense:type_name -> common.Money
-	14, // 4: analyzer.PeriodBalance.balance:type_name -> common.Money
-	1,  // 5: analyzer.PeriodBalance.category_breakdown:type_name -> analyzer.CategorySpending
-	14, // 6: analyzer.CategorySpending.total_amount:type_name -> common.Money
-	13, // 7: analyzer.Forecast.period_start:type_name -> google.protobuf.Timestamp
-	13, // 8: analyzer.Forecast.period_end:type_name -> google.protobuf.Timestamp
-	14, // 9: analyzer.Forecast.expected_income:type_name -> common.Money
-	14, // 10: analyzer.Forecast.expected_expense:type_name -> common.Money
-	14, // 11: analyzer.Forecast.expected_balance:type_name -> common.Money
-	1,  // 12: analyzer.Forecast.category_breakdown:type_name -> analyzer.CategorySpending
-	13, // 13: analyzer.GetStatisticsRequest.start_date:type_name -> google.protobuf.Timestamp
-	13, // 14: analyzer.GetStatisticsRequest.end_date:type_name -> google.protobuf.Timestamp
-	15, // 15: analyzer.GetStatisticsRequest.group_by:type_name -> common.TimePeriod
-	14, // 16: analyzer.GetStatisticsResponse.total_income:type_name -> common.Money
-	14, // 17: analyzer.GetStatisticsResponse.total_expense:type_name -> common.Money
-	0,  // 18: analyzer.GetStatisticsResponse.period_data:type_name -> analyzer.PeriodBalance
-	15, // 19: analyzer.GetForecastRequest.period:type_name -> common.TimePeriod
-	2,  // 20: analyzer.GetForecastResponse.forecasts:type_name -> analyzer.Forecast
-	15, // 21: analyzer.GetAnomaliesRequest.period:type_name -> common.TimePeriod
-	9,  // 22: analyzer.GetAnomaliesResponse.anomalies:type_name -> analyzer.CategoryAnomaly
-	14, // 23: analyzer.CategoryAnomaly.actual_amount:type_name -> common.Money
-	14, // 24: analyzer.CategoryAnomaly.expected_amount:type_name -> common.Money
-	14, // 25: analyzer.CategoryAnomaly.deviation_amount:type_name -> common.Money
-	12, // 26: analyzer.GetUpcomingRecurringResponse.payments:type_name -> analyzer.RecurringPayment
-	14, // 27: analyzer.RecurringPayment.typical_amount:type_name -> common.Money
-	13, // 28: analyzer.RecurringPayment.expected_date:type_name -> google.protobuf.Timestamp
-	3,  // 29: analyzer.AnalyzerService.GetStatistics:input_type -> analyzer.GetStatisticsRequest
-	5,  // 30: analyzer.AnalyzerService.GetForecast:input_type -> analyzer.GetForecastRequest
-	7,  // 31: analyzer.AnalyzerService.GetAnomalies:input_type -> analyzer.GetAnomaliesRequest
-	10, // 32: analyzer.AnalyzerService.GetUpcomingRecurring:input_type -> analyzer.GetUpcomingRecurringRequest
-	4,  // 33: analyzer.AnalyzerService.GetStatistics:output_type -> analyzer.GetStatisticsResponse
-	6,  // 34: analyzer.AnalyzerService.GetForecast:output_type -> analyzer.GetForecastResponse
-	8,  // 35: analyzer.AnalyzerService.GetAnomalies:output_type -> analyzer.GetAnomaliesResponse
-	11, // 36: analyzer.AnalyzerService.GetUpcomingRecurring:output_type -> analyzer.GetUpcomingRecurringResponse
-	33, // [33:37] is the sub-list for method output_type
-	29, // [29:33] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
+	47,  // 0: analyzer.PeriodBalance.period_start:type_name -> google.protobuf.Timestamp
+	47,  // 1: analyzer.PeriodBalance.period_end:type_name -> google.protobuf.Timestamp
+	48,  // 2: analyzer.PeriodBalance.income:type_name -> common.Money
+	48,  // 3: analyzer.PeriodBalance.expense:type_name -> common.Money
+	48,  // 4: analyzer.PeriodBalance.balance:type_name -> common.Money
+	6,   // 5: analyzer.PeriodBalance.category_breakdown:type_name -> analyzer.CategorySpending
+	48,  // 6: analyzer.CategorySpending.total_amount:type_name -> common.Money
+	47,  // 7: analyzer.Forecast.period_start:type_name -> google.protobuf.Timestamp
+	47,  // 8: analyzer.Forecast.period_end:type_name -> google.protobuf.Timestamp
+	48,  // 9: analyzer.Forecast.expected_income:type_name -> common.Money
+	48,  // 10: analyzer.Forecast.expected_expense:type_name -> common.Money
+	48,  // 11: analyzer.Forecast.expected_balance:type_name -> common.Money
+	6,   // 12: analyzer.Forecast.category_breakdown:type_name -> analyzer.CategorySpending
+	8,   // 13: analyzer.Forecast.intervals:type_name -> analyzer.ForecastInterval
+	48,  // 14: analyzer.Forecast.committed_expense:type_name -> common.Money
+	48,  // 15: analyzer.Forecast.discretionary_expense:type_name -> common.Money
+	48,  // 16: analyzer.ForecastInterval.income_lower:type_name -> common.Money
+	48,  // 17: analyzer.ForecastInterval.income_upper:type_name -> common.Money
+	48,  // 18: analyzer.ForecastInterval.expense_lower:type_name -> common.Money
+	48,  // 19: analyzer.ForecastInterval.expense_upper:type_name -> common.Money
+	48,  // 20: analyzer.ForecastInterval.balance_lower:type_name -> common.Money
+	48,  // 21: analyzer.ForecastInterval.balance_upper:type_name -> common.Money
+	47,  // 22: analyzer.GetStatisticsRequest.start_date:type_name -> google.protobuf.Timestamp
+	47,  // 23: analyzer.GetStatisticsRequest.end_date:type_name -> google.protobuf.Timestamp
+	49,  // 24: analyzer.GetStatisticsRequest.group_by:type_name -> common.TimePeriod
+	48,  // 25: analyzer.GetStatisticsResponse.total_income:type_name -> common.Money
+	48,  // 26: analyzer.GetStatisticsResponse.total_expense:type_name -> common.Money
+	5,   // 27: analyzer.GetStatisticsResponse.period_data:type_name -> analyzer.PeriodBalance
+	49,  // 28: analyzer.GetForecastRequest.period:type_name -> common.TimePeriod
+	7,   // 29: analyzer.GetForecastResponse.forecasts:type_name -> analyzer.Forecast
+	13,  // 30: analyzer.GetForecastResponse.income_trend:type_name -> analyzer.ForecastTrend
+	13,  // 31: analyzer.GetForecastResponse.expense_trend:type_name -> analyzer.ForecastTrend
+	49,  // 32: analyzer.GetAnomaliesRequest.period:type_name -> common.TimePeriod
+	47,  // 33: analyzer.GetAnomaliesRequest.period_start:type_name -> google.protobuf.Timestamp
+	19,  // 34: analyzer.GetAnomaliesResponse.anomalies:type_name -> analyzer.CategoryAnomaly
+	16,  // 35: analyzer.GetAnomaliesResponse.thresholds:type_name -> analyzer.AnomalyThresholds
+	0,   // 36: analyzer.AnomalyThresholds.deviation_source:type_name -> analyzer.ThresholdSource
+	48,  // 37: analyzer.AnomalyThresholds.new_category_threshold:type_name -> common.Money
+	0,   // 38: analyzer.AnomalyThresholds.new_category_source:type_name -> analyzer.ThresholdSource
+	48,  // 39: analyzer.SetAnomalyThresholdsRequest.new_category_threshold:type_name -> common.Money
+	48,  // 40: analyzer.CategoryAnomaly.actual_amount:type_name -> common.Money
+	48,  // 41: analyzer.CategoryAnomaly.expected_amount:type_name -> common.Money
+	48,  // 42: analyzer.CategoryAnomaly.deviation_amount:type_name -> common.Money
+	2,   // 43: analyzer.CategoryAnomaly.severity:type_name -> analyzer.AnomalySeverity
+	1,   // 44: analyzer.CategoryAnomaly.direction:type_name -> analyzer.AnomalyDirection
+	50,  // 45: analyzer.CategoryAnomaly.flow_type:type_name -> common.TransactionType
+	26,  // 46: analyzer.CategoryAnomaly.baseline:type_name -> analyzer.BaselinePeriod
+	27,  // 47: analyzer.CategoryAnomaly.top_transactions:type_name -> analyzer.AnomalyTransaction
+	47,  // 48: analyzer.CategoryAnomaly.period_start:type_name -> google.protobuf.Timestamp
+	50,  // 49: analyzer.AcknowledgeAnomalyRequest.flow_type:type_name -> common.TransactionType
+	47,  // 50: analyzer.AcknowledgeAnomalyRequest.period_start:type_name -> google.protobuf.Timestamp
+	49,  // 51: analyzer.AcknowledgeAnomalyRequest.period:type_name -> common.TimePeriod
+	50,  // 52: analyzer.SuppressAnomalyRequest.flow_type:type_name -> common.TransactionType
+	47,  // 53: analyzer.SuppressAnomalyRequest.period_start:type_name -> google.protobuf.Timestamp
+	49,  // 54: analyzer.SuppressAnomalyRequest.period:type_name -> common.TimePeriod
+	50,  // 55: analyzer.UnsuppressAnomalyRequest.flow_type:type_name -> common.TransactionType
+	47,  // 56: analyzer.UnsuppressAnomalyRequest.period_start:type_name -> google.protobuf.Timestamp
+	49,  // 57: analyzer.UnsuppressAnomalyRequest.period:type_name -> common.TimePeriod
+	47,  // 58: analyzer.BaselinePeriod.period_start:type_name -> google.protobuf.Timestamp
+	48,  // 59: analyzer.BaselinePeriod.amount:type_name -> common.Money
+	48,  // 60: analyzer.AnomalyTransaction.amount:type_name -> common.Money
+	47,  // 61: analyzer.AnomalyTransaction.created_at:type_name -> google.protobuf.Timestamp
+	30,  // 62: analyzer.GetTransactionAnomaliesResponse.anomalies:type_name -> analyzer.TransactionAnomaly
+	48,  // 63: analyzer.TransactionAnomaly.amount:type_name -> common.Money
+	47,  // 64: analyzer.TransactionAnomaly.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 65: analyzer.TransactionAnomaly.reasons:type_name -> analyzer.TransactionAnomalyReason
+	49,  // 66: analyzer.GetSpendingPaceRequest.period:type_name -> common.TimePeriod
+	47,  // 67: analyzer.GetSpendingPaceResponse.period_start:type_name -> google.protobuf.Timestamp
+	47,  // 68: analyzer.GetSpendingPaceResponse.period_end:type_name -> google.protobuf.Timestamp
+	33,  // 69: analyzer.GetSpendingPaceResponse.categories:type_name -> analyzer.CategoryPace
+	48,  // 70: analyzer.CategoryPace.spent_amount:type_name -> common.Money
+	48,  // 71: analyzer.CategoryPace.expected_amount:type_name -> common.Money
+	48,  // 72: analyzer.CategoryPace.projected_amount:type_name -> common.Money
+	36,  // 73: analyzer.GetDuplicateChargesResponse.duplicates:type_name -> analyzer.DuplicateCharge
+	27,  // 74: analyzer.DuplicateCharge.original:type_name -> analyzer.AnomalyTransaction
+	27,  // 75: analyzer.DuplicateCharge.duplicate:type_name -> analyzer.AnomalyTransaction
+	39,  // 76: analyzer.GetUpcomingRecurringResponse.payments:type_name -> analyzer.RecurringPayment
+	48,  // 77: analyzer.RecurringPayment.typical_amount:type_name -> common.Money
+	47,  // 78: analyzer.RecurringPayment.expected_date:type_name -> google.protobuf.Timestamp
+	4,   // 79: analyzer.RecurringPayment.cadence:type_name -> analyzer.RecurringCadence
+	49,  // 80: analyzer.EvaluateForecastRequest.period:type_name -> common.TimePeriod
+	42,  // 81: analyzer.EvaluateForecastResponse.results:type_name -> analyzer.ForecastAccuracy
+	43,  // 82: analyzer.ForecastAccuracy.income:type_name -> analyzer.AccuracyMetrics
+	43,  // 83: analyzer.ForecastAccuracy.expense:type_name -> analyzer.AccuracyMetrics
+	48,  // 84: analyzer.GetCashFlowProjectionRequest.threshold:type_name -> common.Money
+	48,  // 85: analyzer.GetCashFlowProjectionRequest.current_balance:type_name -> common.Money
+	48,  // 86: analyzer.GetCashFlowProjectionResponse.starting_balance:type_name -> common.Money
+	48,  // 87: analyzer.GetCashFlowProjectionResponse.daily_discretionary:type_name -> common.Money
+	46,  // 88: analyzer.GetCashFlowProjectionResponse.days:type_name -> analyzer.DailyBalance
+	47,  // 89: analyzer.GetCashFlowProjectionResponse.below_zero_date:type_name -> google.protobuf.Timestamp
+	47,  // 90: analyzer.GetCashFlowProjectionResponse.below_threshold_date:type_name -> google.protobuf.Timestamp
+	47,  // 91: analyzer.DailyBalance.date:type_name -> google.protobuf.Timestamp
+	48,  // 92: analyzer.DailyBalance.income:type_name -> common.Money
+	48,  // 93: analyzer.DailyBalance.expense:type_name -> common.Money
+	48,  // 94: analyzer.DailyBalance.balance:type_name -> common.Money
+	9,   // 95: analyzer.AnalyzerService.GetStatistics:input_type -> analyzer.GetStatisticsRequest
+	11,  // 96: analyzer.AnalyzerService.GetForecast:input_type -> analyzer.GetForecastRequest
+	14,  // 97: analyzer.AnalyzerService.GetAnomalies:input_type -> analyzer.GetAnomaliesRequest
+	20,  // 98: analyzer.AnalyzerService.AcknowledgeAnomaly:input_type -> analyzer.AcknowledgeAnomalyRequest
+	22,  // 99: analyzer.AnalyzerService.SuppressAnomaly:input_type -> analyzer.SuppressAnomalyRequest
+	24,  // 100: analyzer.AnalyzerService.UnsuppressAnomaly:input_type -> analyzer.UnsuppressAnomalyRequest
+	17,  // 101: analyzer.AnalyzerService.SetAnomalyThresholds:input_type -> analyzer.SetAnomalyThresholdsRequest
+	28,  // 102: analyzer.AnalyzerService.GetTransactionAnomalies:input_type -> analyzer.GetTransactionAnomaliesRequest
+	31,  // 103: analyzer.AnalyzerService.GetSpendingPace:input_type -> analyzer.GetSpendingPaceRequest
+	34,  // 104: analyzer.AnalyzerService.GetDuplicateCharges:input_type -> analyzer.GetDuplicateChargesRequest
+	37,  // 105: analyzer.AnalyzerService.GetUpcomingRecurring:input_type -> analyzer.GetUpcomingRecurringRequest
+	40,  // 106: analyzer.AnalyzerService.EvaluateForecast:input_type -> analyzer.EvaluateForecastRequest
+	44,  // 107: analyzer.AnalyzerService.GetCashFlowProjection:input_type -> analyzer.GetCashFlowProjectionRequest
+	10,  // 108: analyzer.AnalyzerService.GetStatistics:output_type -> analyzer.GetStatisticsResponse
+	12,  // 109: analyzer.AnalyzerService.GetForecast:output_type -> analyzer.GetForecastResponse
+	15,  // 110: analyzer.AnalyzerService.GetAnomalies:output_type -> analyzer.GetAnomaliesResponse
+	21,  // 111: analyzer.AnalyzerService.AcknowledgeAnomaly:output_type -> analyzer.AcknowledgeAnomalyResponse
+	23,  // 112: analyzer.AnalyzerService.SuppressAnomaly:output_type -> analyzer.SuppressAnomalyResponse
+	25,  // 113: analyzer.AnalyzerService.UnsuppressAnomaly:output_type -> analyzer.UnsuppressAnomalyResponse
+	18,  // 114: analyzer.AnalyzerService.SetAnomalyThresholds:output_type -> analyzer.SetAnomalyThresholdsResponse
+	29,  // 115: analyzer.AnalyzerService.GetTransactionAnomalies:output_type -> analyzer.GetTransactionAnomaliesResponse
+	32,  // 116: analyzer.AnalyzerService.GetSpendingPace:output_type -> analyzer.GetSpendingPaceResponse
+	35,  // 117: analyzer.AnalyzerService.GetDuplicateCharges:output_type -> analyzer.GetDuplicateChargesResponse
+	38,  // 118: analyzer.AnalyzerService.GetUpcomingRecurring:output_type -> analyzer.GetUpcomingRecurringResponse
+	41,  // 119: analyzer.AnalyzerService.EvaluateForecast:output_type -> analyzer.EvaluateForecastResponse
+	45,  // 120: analyzer.AnalyzerService.GetCashFlowProjection:output_type -> analyzer.GetCashFlowProjectionResponse
+	108, // [108:121] is the sub-list for method output_type
+	95,  // [95:108] is the sub-list for method input_type
+	95,  // [95:95] is the sub-list for extension type_name
+	95,  // [95:95] is the sub-list for extension extendee
+	0,   // [0:95] is the sub-list for field type_name
 }
 
 func init() { file_analyzer_analyzer_proto_init() }
@@ -934,18 +3496,20 @@ func file_analyzer_analyzer_proto_init() {
 	if File_analyzer_analyzer_proto != nil {
 		return
 	}
+	file_analyzer_analyzer_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_analyzer_analyzer_proto_rawDesc), len(file_analyzer_analyzer_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      5,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_analyzer_analyzer_proto_goTypes,
 		DependencyIndexes: file_analyzer_analyzer_proto_depIdxs,
+		EnumInfos:         file_analyzer_analyzer_proto_enumTypes,
 		MessageInfos:      file_analyzer_analyzer_proto_msgTypes,
 	}.Build()
 	File_analyzer_analyzer_proto = out.File
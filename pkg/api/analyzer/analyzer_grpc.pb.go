@@ -19,10 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AnalyzerService_GetStatistics_FullMethodName        = "/analyzer.AnalyzerService/GetStatistics"
-	AnalyzerService_GetForecast_FullMethodName          = "/analyzer.AnalyzerService/GetForecast"
-	AnalyzerService_GetAnomalies_FullMethodName         = "/analyzer.AnalyzerService/GetAnomalies"
-	AnalyzerService_GetUpcomingRecurring_FullMethodName = "/analyzer.AnalyzerService/GetUpcomingRecurring"
+	AnalyzerService_GetStatistics_FullMethodName           = "/analyzer.AnalyzerService/GetStatistics"
+	AnalyzerService_GetForecast_FullMethodName             = "/analyzer.AnalyzerService/GetForecast"
+	AnalyzerService_GetAnomalies_FullMethodName            = "/analyzer.AnalyzerService/GetAnomalies"
+	AnalyzerService_AcknowledgeAnomaly_FullMethodName      = "/analyzer.AnalyzerService/AcknowledgeAnomaly"
+	AnalyzerService_SuppressAnomaly_FullMethodName         = "/analyzer.AnalyzerService/SuppressAnomaly"
+	AnalyzerService_UnsuppressAnomaly_FullMethodName       = "/analyzer.AnalyzerService/UnsuppressAnomaly"
+	AnalyzerService_SetAnomalyThresholds_FullMethodName    = "/analyzer.AnalyzerService/SetAnomalyThresholds"
+	AnalyzerService_GetTransactionAnomalies_FullMethodName = "/analyzer.AnalyzerService/GetTransactionAnomalies"
+	AnalyzerService_GetSpendingPace_FullMethodName         = "/analyzer.AnalyzerService/GetSpendingPace"
+	AnalyzerService_GetDuplicateCharges_FullMethodName     = "/analyzer.AnalyzerService/GetDuplicateCharges"
+	AnalyzerService_GetUpcomingRecurring_FullMethodName    = "/analyzer.AnalyzerService/GetUpcomingRecurring"
+	AnalyzerService_EvaluateForecast_FullMethodName        = "/analyzer.AnalyzerService/EvaluateForecast"
+	AnalyzerService_GetCashFlowProjection_FullMethodName   = "/analyzer.AnalyzerService/GetCashFlowProjection"
 )
 
 // AnalyzerServiceClient is the client API for AnalyzerService service.
@@ -32,7 +41,16 @@ type AnalyzerServiceClient interface {
 	GetStatistics(ctx context.Context, in *GetStatisticsRequest, opts ...grpc.CallOption) (*GetStatisticsResponse, error)
 	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
 	GetAnomalies(ctx context.Context, in *GetAnomaliesRequest, opts ...grpc.CallOption) (*GetAnomaliesResponse, error)
+	AcknowledgeAnomaly(ctx context.Context, in *AcknowledgeAnomalyRequest, opts ...grpc.CallOption) (*AcknowledgeAnomalyResponse, error)
+	SuppressAnomaly(ctx context.Context, in *SuppressAnomalyRequest, opts ...grpc.CallOption) (*SuppressAnomalyResponse, error)
+	UnsuppressAnomaly(ctx context.Context, in *UnsuppressAnomalyRequest, opts ...grpc.CallOption) (*UnsuppressAnomalyResponse, error)
+	SetAnomalyThresholds(ctx context.Context, in *SetAnomalyThresholdsRequest, opts ...grpc.CallOption) (*SetAnomalyThresholdsResponse, error)
+	GetTransactionAnomalies(ctx context.Context, in *GetTransactionAnomaliesRequest, opts ...grpc.CallOption) (*GetTransactionAnomaliesResponse, error)
+	GetSpendingPace(ctx context.Context, in *GetSpendingPaceRequest, opts ...grpc.CallOption) (*GetSpendingPaceResponse, error)
+	GetDuplicateCharges(ctx context.Context, in *GetDuplicateChargesRequest, opts ...grpc.CallOption) (*GetDuplicateChargesResponse, error)
 	GetUpcomingRecurring(ctx context.Context, in *GetUpcomingRecurringRequest, opts ...grpc.CallOption) (*GetUpcomingRecurringResponse, error)
+	EvaluateForecast(ctx context.Context, in *EvaluateForecastRequest, opts ...grpc.CallOption) (*EvaluateForecastResponse, error)
+	GetCashFlowProjection(ctx context.Context, in *GetCashFlowProjectionRequest, opts ...grpc.CallOption) (*GetCashFlowProjectionResponse, error)
 }
 
 type analyzerServiceClient struct {
@@ -73,6 +91,76 @@ func (c *analyzerServiceClient) GetAnomalies(ctx context.Context, in *GetAnomali
 	return out, nil
 }
 
+func (c *analyzerServiceClient) AcknowledgeAnomaly(ctx context.Context, in *AcknowledgeAnomalyRequest, opts ...grpc.CallOption) (*AcknowledgeAnomalyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAnomalyResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_AcknowledgeAnomaly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) SuppressAnomaly(ctx context.Context, in *SuppressAnomalyRequest, opts ...grpc.CallOption) (*SuppressAnomalyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuppressAnomalyResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_SuppressAnomaly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) UnsuppressAnomaly(ctx context.Context, in *UnsuppressAnomalyRequest, opts ...grpc.CallOption) (*UnsuppressAnomalyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsuppressAnomalyResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_UnsuppressAnomaly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) SetAnomalyThresholds(ctx context.Context, in *SetAnomalyThresholdsRequest, opts ...grpc.CallOption) (*SetAnomalyThresholdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAnomalyThresholdsResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_SetAnomalyThresholds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetTransactionAnomalies(ctx context.Context, in *GetTransactionAnomaliesRequest, opts ...grpc.CallOption) (*GetTransactionAnomaliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionAnomaliesResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetTransactionAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetSpendingPace(ctx context.Context, in *GetSpendingPaceRequest, opts ...grpc.CallOption) (*GetSpendingPaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSpendingPaceResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetSpendingPace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetDuplicateCharges(ctx context.Context, in *GetDuplicateChargesRequest, opts ...grpc.CallOption) (*GetDuplicateChargesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDuplicateChargesResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetDuplicateCharges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *analyzerServiceClient) GetUpcomingRecurring(ctx context.Context, in *GetUpcomingRecurringRequest, opts ...grpc.CallOption) (*GetUpcomingRecurringResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUpcomingRecurringResponse)
@@ -83,6 +171,26 @@ func (c *analyzerServiceClient) GetUpcomingRecurring(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *analyzerServiceClient) EvaluateForecast(ctx context.Context, in *EvaluateForecastRequest, opts ...grpc.CallOption) (*EvaluateForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateForecastResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_EvaluateForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *analyzerServiceClient) GetCashFlowProjection(ctx context.Context, in *GetCashFlowProjectionRequest, opts ...grpc.CallOption) (*GetCashFlowProjectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCashFlowProjectionResponse)
+	err := c.cc.Invoke(ctx, AnalyzerService_GetCashFlowProjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnalyzerServiceServer is the server API for AnalyzerService service.
 // All implementations must embed UnimplementedAnalyzerServiceServer
 // for forward compatibility.
@@ -90,7 +198,16 @@ type AnalyzerServiceServer interface {
 	GetStatistics(context.Context, *GetStatisticsRequest) (*GetStatisticsResponse, error)
 	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
 	GetAnomalies(context.Context, *GetAnomaliesRequest) (*GetAnomaliesResponse, error)
+	AcknowledgeAnomaly(context.Context, *AcknowledgeAnomalyRequest) (*AcknowledgeAnomalyResponse, error)
+	SuppressAnomaly(context.Context, *SuppressAnomalyRequest) (*SuppressAnomalyResponse, error)
+	UnsuppressAnomaly(context.Context, *UnsuppressAnomalyRequest) (*UnsuppressAnomalyResponse, error)
+	SetAnomalyThresholds(context.Context, *SetAnomalyThresholdsRequest) (*SetAnomalyThresholdsResponse, error)
+	GetTransactionAnomalies(context.Context, *GetTransactionAnomaliesRequest) (*GetTransactionAnomaliesResponse, error)
+	GetSpendingPace(context.Context, *GetSpendingPaceRequest) (*GetSpendingPaceResponse, error)
+	GetDuplicateCharges(context.Context, *GetDuplicateChargesRequest) (*GetDuplicateChargesResponse, error)
 	GetUpcomingRecurring(context.Context, *GetUpcomingRecurringRequest) (*GetUpcomingRecurringResponse, error)
+	EvaluateForecast(context.Context, *EvaluateForecastRequest) (*EvaluateForecastResponse, error)
+	GetCashFlowProjection(context.Context, *GetCashFlowProjectionRequest) (*GetCashFlowProjectionResponse, error)
 	mustEmbedUnimplementedAnalyzerServiceServer()
 }
 
@@ -110,9 +227,36 @@ func (UnimplementedAnalyzerServiceServer) GetForecast(context.Context, *GetForec
 func (UnimplementedAnalyzerServiceServer) GetAnomalies(context.Context, *GetAnomaliesRequest) (*GetAnomaliesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAnomalies not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) AcknowledgeAnomaly(context.Context, *AcknowledgeAnomalyRequest) (*AcknowledgeAnomalyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAnomaly not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) SuppressAnomaly(context.Context, *SuppressAnomalyRequest) (*SuppressAnomalyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuppressAnomaly not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) UnsuppressAnomaly(context.Context, *UnsuppressAnomalyRequest) (*UnsuppressAnomalyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuppressAnomaly not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) SetAnomalyThresholds(context.Context, *SetAnomalyThresholdsRequest) (*SetAnomalyThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnomalyThresholds not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetTransactionAnomalies(context.Context, *GetTransactionAnomaliesRequest) (*GetTransactionAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionAnomalies not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetSpendingPace(context.Context, *GetSpendingPaceRequest) (*GetSpendingPaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingPace not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetDuplicateCharges(context.Context, *GetDuplicateChargesRequest) (*GetDuplicateChargesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDuplicateCharges not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) GetUpcomingRecurring(context.Context, *GetUpcomingRecurringRequest) (*GetUpcomingRecurringResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUpcomingRecurring not implemented")
 }
+func (UnimplementedAnalyzerServiceServer) EvaluateForecast(context.Context, *EvaluateForecastRequest) (*EvaluateForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateForecast not implemented")
+}
+func (UnimplementedAnalyzerServiceServer) GetCashFlowProjection(context.Context, *GetCashFlowProjectionRequest) (*GetCashFlowProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCashFlowProjection not implemented")
+}
 func (UnimplementedAnalyzerServiceServer) mustEmbedUnimplementedAnalyzerServiceServer() {}
 func (UnimplementedAnalyzerServiceServer) testEmbeddedByValue()                         {}
 
@@ -188,6 +332,132 @@ func _AnalyzerService_GetAnomalies_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_AcknowledgeAnomaly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAnomalyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).AcknowledgeAnomaly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_AcknowledgeAnomaly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).AcknowledgeAnomaly(ctx, req.(*AcknowledgeAnomalyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_SuppressAnomaly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuppressAnomalyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).SuppressAnomaly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_SuppressAnomaly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).SuppressAnomaly(ctx, req.(*SuppressAnomalyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_UnsuppressAnomaly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuppressAnomalyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).UnsuppressAnomaly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_UnsuppressAnomaly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).UnsuppressAnomaly(ctx, req.(*UnsuppressAnomalyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_SetAnomalyThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnomalyThresholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).SetAnomalyThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_SetAnomalyThresholds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).SetAnomalyThresholds(ctx, req.(*SetAnomalyThresholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetTransactionAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetTransactionAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetTransactionAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetTransactionAnomalies(ctx, req.(*GetTransactionAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetSpendingPace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingPaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetSpendingPace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetSpendingPace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetSpendingPace(ctx, req.(*GetSpendingPaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetDuplicateCharges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDuplicateChargesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetDuplicateCharges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetDuplicateCharges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetDuplicateCharges(ctx, req.(*GetDuplicateChargesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AnalyzerService_GetUpcomingRecurring_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUpcomingRecurringRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +476,42 @@ func _AnalyzerService_GetUpcomingRecurring_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AnalyzerService_EvaluateForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).EvaluateForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_EvaluateForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).EvaluateForecast(ctx, req.(*EvaluateForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AnalyzerService_GetCashFlowProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCashFlowProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnalyzerServiceServer).GetCashFlowProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AnalyzerService_GetCashFlowProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnalyzerServiceServer).GetCashFlowProjection(ctx, req.(*GetCashFlowProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AnalyzerService_ServiceDesc is the grpc.ServiceDesc for AnalyzerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,10 +531,46 @@ var AnalyzerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAnomalies",
 			Handler:    _AnalyzerService_GetAnomalies_Handler,
 		},
+		{
+			MethodName: "AcknowledgeAnomaly",
+			Handler:    _AnalyzerService_AcknowledgeAnomaly_Handler,
+		},
+		{
+			MethodName: "SuppressAnomaly",
+			Handler:    _AnalyzerService_SuppressAnomaly_Handler,
+		},
+		{
+			MethodName: "UnsuppressAnomaly",
+			Handler:    _AnalyzerService_UnsuppressAnomaly_Handler,
+		},
+		{
+			MethodName: "SetAnomalyThresholds",
+			Handler:    _AnalyzerService_SetAnomalyThresholds_Handler,
+		},
+		{
+			MethodName: "GetTransactionAnomalies",
+			Handler:    _AnalyzerService_GetTransactionAnomalies_Handler,
+		},
+		{
+			MethodName: "GetSpendingPace",
+			Handler:    _AnalyzerService_GetSpendingPace_Handler,
+		},
+		{
+			MethodName: "GetDuplicateCharges",
+			Handler:    _AnalyzerService_GetDuplicateCharges_Handler,
+		},
 		{
 			MethodName: "GetUpcomingRecurring",
 			Handler:    _AnalyzerService_GetUpcomingRecurring_Handler,
 		},
+		{
+			MethodName: "EvaluateForecast",
+			Handler:    _AnalyzerService_EvaluateForecast_Handler,
+		},
+		{
+			MethodName: "GetCashFlowProjection",
+			Handler:    _AnalyzerService_GetCashFlowProjection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "analyzer/analyzer.proto",
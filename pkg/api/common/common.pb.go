@@ -130,6 +130,11 @@ const (
 	TimePeriod_TIME_PERIOD_MONTH       TimePeriod = 1
 	TimePeriod_TIME_PERIOD_QUARTER     TimePeriod = 2
 	TimePeriod_TIME_PERIOD_YEAR        TimePeriod = 3
+	TimePeriod_TIME_PERIOD_WEEK        TimePeriod = 4
+	TimePeriod_TIME_PERIOD_DAY         TimePeriod = 5
+	TimePeriod_TIME_PERIOD_PAY_CYCLE   TimePeriod = 6
+	TimePeriod_TIME_PERIOD_HALF_YEAR   TimePeriod = 7
+	TimePeriod_TIME_PERIOD_FISCAL_YEAR TimePeriod = 8
 )
 
 // Enum value maps for TimePeriod.
@@ -139,12 +144,22 @@ var (
 		1: "TIME_PERIOD_MONTH",
 		2: "TIME_PERIOD_QUARTER",
 		3: "TIME_PERIOD_YEAR",
+		4: "TIME_PERIOD_WEEK",
+		5: "TIME_PERIOD_DAY",
+		6: "TIME_PERIOD_PAY_CYCLE",
+		7: "TIME_PERIOD_HALF_YEAR",
+		8: "TIME_PERIOD_FISCAL_YEAR",
 	}
 	TimePeriod_value = map[string]int32{
 		"TIME_PERIOD_UNSPECIFIED": 0,
 		"TIME_PERIOD_MONTH":       1,
 		"TIME_PERIOD_QUARTER":     2,
 		"TIME_PERIOD_YEAR":        3,
+		"TIME_PERIOD_WEEK":        4,
+		"TIME_PERIOD_DAY":         5,
+		"TIME_PERIOD_PAY_CYCLE":   6,
+		"TIME_PERIOD_HALF_YEAR":   7,
+		"TIME_PERIOD_FISCAL_YEAR": 8,
 	}
 )
 
@@ -308,13 +323,18 @@ const file_common_common_proto_rawDesc = "" +
 	"\vAccountType\x12\x1c\n" +
 	"\x18ACCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14ACCOUNT_TYPE_REGULAR\x10\x01\x12\x1b\n" +
-	"\x17ACCOUNT_TYPE_INVESTMENT\x10\x02*o\n" +
+	"\x17ACCOUNT_TYPE_INVESTMENT\x10\x02*\xed\x01\n" +
 	"\n" +
 	"TimePeriod\x12\x1b\n" +
 	"\x17TIME_PERIOD_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11TIME_PERIOD_MONTH\x10\x01\x12\x17\n" +
 	"\x13TIME_PERIOD_QUARTER\x10\x02\x12\x14\n" +
-	"\x10TIME_PERIOD_YEAR\x10\x03B\fZ\n" +
+	"\x10TIME_PERIOD_YEAR\x10\x03\x12\x14\n" +
+	"\x10TIME_PERIOD_WEEK\x10\x04\x12\x13\n" +
+	"\x0fTIME_PERIOD_DAY\x10\x05\x12\x19\n" +
+	"\x15TIME_PERIOD_PAY_CYCLE\x10\x06\x12\x19\n" +
+	"\x15TIME_PERIOD_HALF_YEAR\x10\a\x12\x1b\n" +
+	"\x17TIME_PERIOD_FISCAL_YEAR\x10\bB\fZ\n" +
 	"api-commonb\x06proto3"
 
 var (
@@ -14,7 +14,8 @@ grpcurl -plaintext -d '{
   "user_id": "'$USER_ID'",
   "start_date": "2025-06-01T00:00:00Z",
   "end_date": "2025-11-30T23:59:59Z",
-  "group_by": "TIME_PERIOD_MONTH"
+  "group_by": "TIME_PERIOD_MONTH",
+  "fill_gaps": true
 }' $HOST analyzer.AnalyzerService/GetStatistics
 echo ""
 echo ""
@@ -46,6 +47,104 @@ grpcurl -plaintext -d '{
 echo ""
 echo ""
 
+echo "5. EvaluateForecast - оценка точности методов прогноза"
+echo "--------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "period": "TIME_PERIOD_MONTH",
+  "horizon": 1
+}' $HOST analyzer.AnalyzerService/EvaluateForecast
+echo ""
+echo ""
+
+echo "6. GetCashFlowProjection - баланс по дням до зарплаты"
+echo "------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "horizon_days": 30
+}' $HOST analyzer.AnalyzerService/GetCashFlowProjection
+echo ""
+echo ""
+
+echo "7. GetTransactionAnomalies - подозрительные транзакции за неделю"
+echo "------------------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "days": 7
+}' $HOST analyzer.AnalyzerService/GetTransactionAnomalies
+echo ""
+echo ""
+
+echo "8. AcknowledgeAnomaly - подтвердить аномалию категории за период"
+echo "-------------------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "mcc": "5411",
+  "flow_type": "TRANSACTION_TYPE_EXPENSE",
+  "period_start": "2024-06-01T00:00:00Z",
+  "period": "TIME_PERIOD_MONTH"
+}' $HOST analyzer.AnalyzerService/AcknowledgeAnomaly
+echo ""
+echo ""
+
+echo "9. SuppressAnomaly - скрыть аномалии категории навсегда"
+echo "---------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "mcc": "5812",
+  "flow_type": "TRANSACTION_TYPE_EXPENSE"
+}' $HOST analyzer.AnalyzerService/SuppressAnomaly
+echo ""
+echo ""
+
+echo "10. GetSpendingPace - темп трат в текущем месяце"
+echo "---------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "period": "TIME_PERIOD_MONTH"
+}' $HOST analyzer.AnalyzerService/GetSpendingPace
+echo ""
+echo ""
+
+echo "11. GetDuplicateCharges - двойные списания за 30 дней"
+echo "--------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "days": 30
+}' $HOST analyzer.AnalyzerService/GetDuplicateCharges
+echo ""
+echo ""
+
+echo "12. GetAnomalies - что было необычного в марте 2024"
+echo "------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "period": "TIME_PERIOD_MONTH",
+  "period_start": "2024-03-01T00:00:00Z"
+}' $HOST analyzer.AnalyzerService/GetAnomalies
+echo ""
+echo ""
+
+echo "13. SetAnomalyThresholds - свои пороги аномалий"
+echo "---------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "deviation_threshold": 80,
+  "new_category_threshold": {"amount": 2000000, "currency": "RUB"}
+}' $HOST analyzer.AnalyzerService/SetAnomalyThresholds
+echo ""
+echo ""
+
+echo "14. UnsuppressAnomaly - снова показывать аномалии категории"
+echo "-------------------------------------------------------------"
+grpcurl -plaintext -d '{
+  "user_id": "'$USER_ID'",
+  "mcc": "5812",
+  "flow_type": "TRANSACTION_TYPE_EXPENSE"
+}' $HOST analyzer.AnalyzerService/UnsuppressAnomaly
+echo ""
+echo ""
+
 echo "=========================================="
 echo "Тестирование завершено!"
 